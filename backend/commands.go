@@ -0,0 +1,503 @@
+package main
+
+import (
+	"backend_main/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// matchFlags are the flags choosing how a pattern is matched.
+type matchFlags struct {
+	algo           *string
+	mode           *string
+	ignore_case    *bool
+	ignore_accents *bool
+}
+
+func addMatchFlags(fs *flag.FlagSet) *matchFlags {
+	return &matchFlags{
+		algo:           fs.String("algo", "auto", "matching algorithm : auto, regex or kmp (auto uses kmp for patterns without regex special characters)"),
+		mode:           fs.String("mode", "longest", "regex match reported among the ones starting at the same position : longest or shortest"),
+		ignore_case:    fs.Bool("i", false, "ignore case"),
+		ignore_accents: fs.Bool("a", false, "ignore accents"),
+	}
+}
+
+// newSearcher compiles the pattern with the flags.
+func (f *matchFlags) newSearcher(pattern string, context utils.ContextSize) (*searcher, error) {
+	mode, err := utils.ParseMatchMode(*f.mode)
+	if err != nil {
+		return nil, err
+	}
+	if *f.algo != utils.AlgoAuto && *f.algo != utils.AlgoRegex && *f.algo != utils.AlgoKMP {
+		return nil, fmt.Errorf("unknown algo %q, expected auto, regex or kmp", *f.algo)
+	}
+	s, err := newSearcher(pattern, utils.Options{
+		Algo:          *f.algo,
+		Mode:          mode,
+		IgnoreCase:    *f.ignore_case,
+		IgnoreAccents: *f.ignore_accents,
+		Context:       context,
+	})
+	if err != nil {
+		printPatternError(pattern, err)
+		return nil, errReported
+	}
+	return s, nil
+}
+
+// loadIndex returns the index at path, or the default index of the corpus
+// when path is empty and it exists.
+func loadIndex(corpus string, path string) (*utils.Index, error) {
+	if path == "" {
+		path = utils.DefaultIndexPath(corpus)
+		if _, err := os.Stat(path); err != nil {
+			return nil, nil
+		}
+	}
+	return utils.LoadIndex(path)
+}
+
+func runSearch(c command, args []string) error {
+	fs := newFlagSet(c)
+	match := addMatchFlags(fs)
+	rank := fs.String("rank", utils.RankMatches, "ranking of the books : "+strings.Join(utils.RankMethods, ", "))
+	max_lines := fs.Int("max", 10, "matching lines shown per book, 0 shows them all")
+	format := fs.String("format", "text", "output format : text, json (one summary object) or ndjson (one object per match, then stats)")
+	index_path := fs.String("index", "", "index file, index.gob of the corpus by default when it exists")
+	after := fs.Int("A", 0, "lines of context shown after every matching line")
+	before := fs.Int("B", 0, "lines of context shown before every matching line")
+	around := fs.Int("C", 0, "lines of context shown before and after every matching line, unless -A or -B is given")
+	workers := fs.Int("j", 0, "books searched in parallel, GOMAXPROCS when 0")
+	chunks := fs.Int("chunks", 0, "parts of each book file scanned in parallel, for very large files (parts are at least 64 KB)")
+	args, err := parseArgs(fs, args, 1, 2)
+	if err != nil {
+		return err
+	}
+	context_lines, err := contextSize(fs, *before, *after, *around)
+	if err != nil {
+		return err
+	}
+	pattern, corpus := args[0], defaultCorpus
+	if len(args) == 2 {
+		corpus = args[1]
+	}
+	if *format != "text" && *format != "json" && *format != "ndjson" {
+		return fmt.Errorf("unknown format %q, expected text, json or ndjson", *format)
+	}
+	if *max_lines < 0 {
+		return fmt.Errorf("-max must not be negative")
+	}
+	if *workers < 0 || *chunks < 0 {
+		return fmt.Errorf("-j and -chunks must not be negative")
+	}
+	// Ctrl-C stops the search
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Load books : a text file, a directory of text files or books.json
+	books, err := utils.LoadCorpus(corpus)
+	if err != nil {
+		return err
+	}
+	s, err := match.newSearcher(pattern, context_lines)
+	if err != nil {
+		return err
+	}
+	s.workers, s.chunks = *workers, *chunks
+	if *format != "ndjson" {
+		s.maxLines = *max_lines
+	}
+	s.index, err = loadIndex(corpus, *index_path)
+	if err != nil {
+		return err
+	}
+	if err := utils.CheckRankMethod(*rank, s.index); err != nil {
+		return err
+	}
+
+	if *format == "ndjson" {
+		return streamSearch(ctx, s, books)
+	}
+	if *format == "text" {
+		fmt.Println("-----")
+		fmt.Println("Pattern :", pattern)
+		fmt.Println("-----")
+		fmt.Printf("Used < %s >  algo.\n", s.algo)
+	}
+
+	// Matching
+	time_before := time.Now()
+	candidates := s.candidates(books)
+	if len(candidates) < len(books) && *format == "text" {
+		println("Index : scanning", len(candidates), "of", len(books), "books.")
+	}
+	results, err := s.searchBooks(ctx, candidates)
+	time_after := time.Now()
+	if err != nil {
+		return err
+	}
+	results, err = s.rankResults(results, *rank, len(books))
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		resp := newSearchResponse(s, *rank, len(books), len(candidates), time_after.Sub(time_before), results)
+		paths := map[string]string{}
+		for _, result := range results {
+			paths[result.Book.ID] = result.Book.Path
+		}
+		for i := range resp.Matches {
+			resp.Matches[i].File = paths[resp.Matches[i].Book]
+		}
+		resp.Suggestions = suggestedBooks(s, results, 5)
+		if err := json.NewEncoder(os.Stdout).Encode(resp); err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			return errNoMatch
+		}
+		return nil
+	}
+	printResults(results, len(books), context_lines, isTerminal(os.Stdout))
+	if suggestions := s.suggestions(results, 5); len(suggestions) > 0 {
+		fmt.Println("-----")
+		fmt.Println("Suggested books :")
+		for _, suggestion := range suggestions {
+			fmt.Printf("- %s (%s) : similarity %.3f\n", s.index.Books[suggestion.Book].Title, suggestion.Book, suggestion.Similarity)
+		}
+	}
+	fmt.Printf("> Time taken for < %s > matching : %v ms\n", map[string]string{"regex": "RegEx", "kmp": "KMP"}[s.algo], time_after.Sub(time_before).Milliseconds())
+	if len(results) == 0 {
+		return errNoMatch
+	}
+	return nil
+}
+
+// streamSearch writes every match as soon as its book is scanned, one JSON
+// object per line, then the stats of the search. Books are searched in
+// parallel, so their matches come in no particular order, but the matches
+// of a book are written together and in line order.
+func streamSearch(ctx context.Context, s *searcher, books []utils.Book) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	encoder := json.NewEncoder(os.Stdout)
+	stats := statsLine{Type: "stats", Pattern: s.pattern, Algo: s.algo, Books: len(books)}
+	time_before := time.Now()
+	candidates := s.candidates(books)
+	stats.Scanned = len(candidates)
+	for searched := range s.searchParallel(ctx, candidates) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if searched.err != nil {
+			return searched.err
+		}
+		result, book := searched.result, searched.result.Book
+		stats.Count += result.Count
+		stats.Lines += result.Matching
+		for _, match := range result.Matches {
+			for _, span := range matchSpans(match) {
+				err := encoder.Encode(matchLine{
+					Type:  "match",
+					File:  book.Path,
+					Book:  book.ID,
+					Title: book.Title,
+					Line:  match.Line,
+					Start: span.Start,
+					End:   span.End,
+					Text:  span.Text,
+					Full:  match.Text,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	stats.TimeMs = time.Since(time_before).Milliseconds()
+	if err := encoder.Encode(stats); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if stats.Count == 0 {
+		return errNoMatch
+	}
+	return nil
+}
+
+// contextSize returns the context lines asked by -B, -A and -C, -C being
+// overridden by the other two like in grep.
+func contextSize(fs *flag.FlagSet, before int, after int, around int) (utils.ContextSize, error) {
+	if before < 0 || after < 0 || around < 0 {
+		return utils.ContextSize{}, errors.New("-A, -B and -C must not be negative")
+	}
+	context := utils.ContextSize{Before: around, After: around}
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "A":
+			context.After = after
+		case "B":
+			context.Before = before
+		}
+	})
+	return context, nil
+}
+
+func runIndex(c command, args []string) error {
+	fs := newFlagSet(c)
+	index_path := fs.String("o", "", "index file, index.gob inside the books directory (or next to the file) by default")
+	args, err := parseArgs(fs, args, 0, 1)
+	if err != nil {
+		return err
+	}
+	corpus := defaultCorpus
+	if len(args) == 1 {
+		corpus = args[0]
+	}
+	if *index_path == "" {
+		*index_path = utils.DefaultIndexPath(corpus)
+	}
+	return buildIndex(corpus, *index_path)
+}
+
+func runServe(c command, args []string) error {
+	fs := newFlagSet(c)
+	addr := fs.String("addr", ":9111", "address to listen on")
+	args, err := parseArgs(fs, args, 0, 1)
+	if err != nil {
+		return err
+	}
+	corpus := defaultCorpus
+	if len(args) == 1 {
+		corpus = args[0]
+	}
+	return serve(*addr, corpus)
+}
+
+// runDot shows how a regex is compiled and writes its automata as
+// nfa.dot, dfa.dot and min_dfa.dot.
+func runDot(c command, args []string) error {
+	fs := newFlagSet(c)
+	out := fs.String("o", "../outputs", "directory of the DOT files")
+	ignore_case := fs.Bool("i", false, "ignore case")
+	ignore_accents := fs.Bool("a", false, "ignore accents")
+	args, err := parseArgs(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	pattern := args[0]
+
+	fmt.Println("-----")
+	fmt.Println("Pattern :", pattern)
+	fmt.Println("-----")
+
+	// Regex Tree
+	tree, err := (&utils.RegexTreeNode{}).ParseRegex(pattern)
+	if err != nil {
+		printPatternError(pattern, err)
+		return errReported
+	}
+	if *ignore_accents {
+		tree.StripAccents()
+	}
+	fmt.Print("Regex tree : ")
+	tree.PrintTree()
+	fmt.Println()
+	if fragments := tree.RequiredLiterals(); len(fragments) > 0 {
+		fmt.Printf("Required literals : %q\n", fragments)
+	}
+	fmt.Println("-----")
+
+	// NDFA
+	nfa := utils.BuildNFA(tree, *ignore_case)
+	if err := nfa.ToDOT(filepath.Join(*out, "nfa.dot")); err != nil {
+		return err
+	}
+	// DFA
+	dfa, err := utils.NFAToDFA(nfa)
+	if err != nil {
+		return err
+	}
+	if err := dfa.ToDOT(filepath.Join(*out, "dfa.dot")); err != nil {
+		return err
+	}
+	dfa_min := dfa.Minimize() // minimisation
+	if err := dfa_min.ToDOT(filepath.Join(*out, "min_dfa.dot")); err != nil {
+		return err
+	}
+	fmt.Println("Automata written to", filepath.Join(*out, "{nfa,dfa,min_dfa}.dot"))
+	return nil
+}
+
+// runBench times regex and KMP matching of the pattern over the books, KMP
+// only for patterns without regex special characters.
+func runBench(c command, args []string) error {
+	fs := newFlagSet(c)
+	runs := fs.Int("n", 5, "number of runs of each algorithm")
+	ignore_case := fs.Bool("i", false, "ignore case")
+	ignore_accents := fs.Bool("a", false, "ignore accents")
+	workers := fs.Int("j", 0, "books searched in parallel, GOMAXPROCS when 0")
+	chunks := fs.Int("chunks", 0, "parts of each book file scanned in parallel, for very large files (parts are at least 64 KB)")
+	args, err := parseArgs(fs, args, 1, 2)
+	if err != nil {
+		return err
+	}
+	pattern, corpus := args[0], defaultCorpus
+	if len(args) == 2 {
+		corpus = args[1]
+	}
+	if *runs < 1 {
+		return errors.New("-n must be at least 1")
+	}
+	if *workers < 0 || *chunks < 0 {
+		return errors.New("-j and -chunks must not be negative")
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	books, err := utils.LoadCorpus(corpus)
+	if err != nil {
+		return err
+	}
+
+	algos := []string{"regex"}
+	if utils.DetectAlgo(pattern) == utils.AlgoKMP {
+		algos = append(algos, "kmp")
+	}
+	fmt.Println("Pattern :", pattern, "-", len(books), "books,", *runs, "runs,", workerCount(*workers), "workers")
+	fmt.Printf("%-6s %12s %12s %12s %9s\n", "algo", "compile", "min", "mean", "matches")
+	for _, algo := range algos {
+		time_before := time.Now()
+		s, err := newSearcher(pattern, utils.Options{Algo: algo, IgnoreCase: *ignore_case, IgnoreAccents: *ignore_accents})
+		if err != nil {
+			printPatternError(pattern, err)
+			return errReported
+		}
+		compile := time.Since(time_before)
+		s.workers, s.chunks = *workers, *chunks
+
+		var min, total time.Duration
+		count := 0
+		for run := 0; run < *runs; run++ {
+			time_before := time.Now()
+			results, err := s.searchBooks(ctx, books)
+			elapsed := time.Since(time_before)
+			if err != nil {
+				return err
+			}
+			if run == 0 || elapsed < min {
+				min = elapsed
+			}
+			total += elapsed
+			count = 0
+			for _, result := range results {
+				count += result.Count
+			}
+		}
+		fmt.Printf("%-6s %12v %12v %12v %9d\n", algo, compile.Round(time.Microsecond), min.Round(time.Microsecond), (total / time.Duration(*runs)).Round(time.Microsecond), count)
+	}
+	return nil
+}
+
+// buildIndex tokenizes every book of the corpus and saves the inverted index.
+func buildIndex(corpus string, index_path string) error {
+	books, err := utils.LoadCorpus(corpus)
+	if err != nil {
+		return err
+	}
+
+	time_before := time.Now()
+	index, err := utils.BuildIndex(books)
+	if err != nil {
+		return err
+	}
+	err = index.Save(index_path)
+	if err != nil {
+		return err
+	}
+	time_after := time.Now()
+
+	fmt.Println("Indexed", len(index.Books), "books,", len(index.Postings), "terms.")
+	fmt.Println("Index saved to", index_path)
+	fmt.Println("> Time taken for indexing :", time_after.Sub(time_before).Milliseconds(), "ms")
+	return nil
+}
+
+// isTerminal reports whether f is a terminal, not a file or a pipe.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// highlight wraps every match of the line in terminal colors.
+func highlight(text string, matches []utils.Match) string {
+	runes := []rune(text)
+	out := ""
+	last := 0
+	for _, m := range matches {
+		out += string(runes[last:m.Start]) + "\033[1;31m" + string(runes[m.Start:m.End]) + "\033[0m"
+		last = m.End
+	}
+	return out + string(runes[last:])
+}
+
+// printResults shows the matching lines kept for each book, see
+// searcher.maxLines, with their context lines. When several books were
+// searched, matches are grouped under each book title, most relevant books
+// first. Like grep, matching lines are marked by ':', context lines by '-'
+// and "--" separates groups of lines which do not follow each other.
+// Matches are highlighted with color, when stdout is a terminal.
+func printResults(results []bookResult, books_searched int, context utils.ContextSize, color bool) {
+	if len(results) == 0 {
+		fmt.Println("No matches found.")
+		return
+	}
+	if books_searched > 1 {
+		total := 0
+		for _, result := range results {
+			total += result.Count
+		}
+		fmt.Println("Books searched :", books_searched, "- books matching :", len(results), "- matches found :", total)
+	}
+
+	for _, result := range results {
+		if books_searched > 1 {
+			fmt.Println("-----")
+			fmt.Printf("== %s (%s) : %v matches in %v lines - score %.4g\n", result.Book.Title, result.Book.ID, result.Count, result.Matching, result.Score)
+		} else {
+			fmt.Println("Matches found :", result.Count, "in", result.Matching, "lines")
+		}
+		for i, match := range result.Matches {
+			if i > 0 && context != (utils.ContextSize{}) && match.FirstLine() > result.Matches[i-1].LastLine()+1 {
+				fmt.Println("--")
+			}
+			printContext(match.Before)
+			text := match.Text
+			if color {
+				text = highlight(text, match.Matches)
+			}
+			fmt.Println("#", match.Line, ":", strings.TrimSpace(text))
+			printContext(match.After)
+		}
+		if more := result.Matching - len(result.Matches); more > 0 {
+			fmt.Printf("... %v more lines\n", more)
+		}
+	}
+}
+
+func printContext(lines []utils.ContextLine) {
+	for _, line := range lines {
+		fmt.Println("#", line.Line, "-", strings.TrimSpace(line.Text))
+	}
+}
@@ -2,182 +2,163 @@ package main
 
 import (
 	"backend_main/utils"
-	"bufio"
-	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
-	"log"
 	"os"
 	"strings"
-	"time"
 )
 
-func loadTitles() map[int]string {
-	books := make(map[int]string)
+// Exit codes, like grep
+const (
+	exitOK      = 0 // success, search found matches
+	exitNoMatch = 1 // search found nothing
+	exitError   = 2
+)
 
-	data, err := os.ReadFile("./resources/books.json")
-	if err != nil {
-		log.Fatal(err)
-	}
-	err = json.Unmarshal(data, &books)
-	if err != nil {
-		log.Fatal(err)
-	}
+var (
+	// errNoMatch is returned by search when no book matches.
+	errNoMatch = errors.New("no matches found")
+	// errReported is returned once the error was shown to the user.
+	errReported = errors.New("error already reported")
+)
 
-	return books
+// command is a subcommand of the command line.
+type command struct {
+	name  string
+	args  string
+	short string
+	run   func(c command, args []string) error
 }
 
-type application struct {
-	pattern string
-	file    string
-	algo    string
+var commands = []command{
+	{"search", "[flags] PATTERN [CORPUS]", "search a pattern in the books", runSearch},
+	{"index", "[flags] [CORPUS]", "build the inverted index of the books", runIndex},
+	{"serve", "[flags] [CORPUS]", "run the HTTP search server", runServe},
+	{"dot", "[flags] PATTERN", "write the NFA and DFA of a regex as DOT files", runDot},
+	{"bench", "[flags] PATTERN [CORPUS]", "time the matching algorithms on the books", runBench},
 }
 
-func main() {
-	// Load args
-	var app application
-
-	if len(os.Args) == 4 { // algo mentioned
-		app = application{
-			pattern: os.Args[1],
-			file:    os.Args[2],
-			algo:    os.Args[3],
-		}
+// defaultCorpus is used when no CORPUS is given.
+const defaultCorpus = "../resources"
+
+// printPatternError shows a regex syntax error under the pattern :
+//
+//	Invalid pattern : unclosed '(' at column 3
+//	  ab(cd
+//	    ^
+func printPatternError(pattern string, err error) {
+	println("Invalid pattern :", err.Error())
+	var syntax_err *utils.SyntaxError
+	if errors.As(err, &syntax_err) {
+		println("  " + pattern)
+		println("  " + strings.Repeat(" ", syntax_err.Column-1) + "^")
 	}
-	if len(os.Args) == 3 { // algo not mentioned
-		pattern := os.Args[1]
-		regex_special_chars := []rune{'|', '.', '*', '+', '?', '(', ')', '[', ']', '\\', '^', '$'}
-		is_regex := false
-		for _, c := range pattern {
-			if strings.ContainsRune(string(regex_special_chars), c) {
-				is_regex = true
-				break
-			}
-		}
+}
 
-		var algo string
-		if is_regex {
-			algo = "regex"
-		} else {
-			algo = "kmp"
-		}
+func usage() {
+	println("Usage : go run . <command> [flags] [arguments]")
+	println("")
+	println("Commands :")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-7s %-26s %s\n", c.name, c.args, c.short)
+	}
+	println("")
+	println("CORPUS is a text file, a directory of text files or a books.json file,", defaultCorpus, "by default.")
+	println("Run 'go run . <command> -h' for the flags of a command.")
+}
 
-		app = application{
-			pattern: pattern,
-			file:    os.Args[2],
-			algo:    algo,
-		}
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(exitError)
 	}
-	if len(os.Args) < 3 {
-		app = application{
-			pattern: "exampSargonle",
-			file:    "../resources/livre_sur_babylone.txt",
-			algo:    "kmp",
-		}
+	name := os.Args[1]
+	if name == "help" || name == "-h" || name == "-help" || name == "--help" {
+		usage()
+		return
 	}
 
-	// Read file arg
-	file, err := os.Open(app.file)
-	if err != nil {
-		panic(err)
+	for _, c := range commands {
+		if c.name != name {
+			continue
+		}
+		err := c.run(c, os.Args[2:])
+		switch {
+		case err == nil, errors.Is(err, flag.ErrHelp):
+			os.Exit(exitOK)
+		case errors.Is(err, errNoMatch):
+			os.Exit(exitNoMatch)
+		case !errors.Is(err, errReported):
+			println("Error :", err.Error())
+		}
+		os.Exit(exitError)
 	}
-	defer file.Close()
-
-	println("-----")
-	println("Pattern :", app.pattern)
-	println("-----")
-	fmt.Printf("Used < %s >  algo.\n", app.algo)
 
-	// Choosing algo
-	if app.algo == "regex" {
-		// Regex
-		// Fixing parentheses
-		pattern_par := utils.AddParentheses(app.pattern)
-		if app.pattern != pattern_par {
-			println("Pattern after fixing parentheses : ", pattern_par)
-		}
+	println("Unknown command :", name)
+	println("")
+	usage()
+	os.Exit(exitError)
+}
 
-		// Regex Tree
-		tree := &utils.RegexTreeNode{}
-		tree = tree.ParseRegex(app.pattern)
-		print("Regex tree : ")
-		tree.PrintTree()
+// newFlagSet returns the flag set of a command, with its usage text.
+func newFlagSet(c command) *flag.FlagSet {
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	fs.Usage = func() {
+		println("Usage : go run .", c.name, c.args)
 		println("")
-		println("-----")
+		println(strings.ToUpper(c.short[:1]) + c.short[1:] + ".")
+		println("")
+		println("Flags :")
+		fs.PrintDefaults()
+	}
+	return fs
+}
 
-		// NDFA
-		nfa := utils.BuildNFA(tree)
-		err = nfa.ToDOT("../outputs/nfa.dot")
-		if err != nil {
-			panic(err)
+// parseArgs parses the flags of a command and returns its arguments, between
+// min and max of them. Flags may also follow the arguments, as in
+// "search Sargon ../resources -i". Arguments starting with '-' must come
+// after "--".
+func parseArgs(fs *flag.FlagSet, args []string, min int, max int) ([]string, error) {
+	flags, positional := []string{}, []string{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
 		}
-
-		// DFA
-		dfa := utils.NFAToDFA(nfa)
-		err = dfa.ToDOT("../outputs/dfa.dot")
-		if err != nil {
-			panic(err)
+		if len(arg) < 2 || arg[0] != '-' {
+			positional = append(positional, arg)
+			continue
 		}
-		dfa_min := dfa.Minimize() // minimisation
-		err = dfa_min.ToDOT("../outputs/min_dfa.dot")
-		if err != nil {
-			panic(err)
+		flags = append(flags, arg)
+		name := strings.TrimLeft(arg, "-")
+		if strings.Contains(name, "=") {
+			continue
 		}
-
-		// Matching
-		scanner := bufio.NewScanner(file)
-		time_before := time.Now()
-		matched, number_matches, matches := utils.MatchAllText(dfa_min.Start, scanner)
-		time_after := time.Now()
-		if matched {
-			println("Matches found :", number_matches)
-			matches_showed := 0
-
-			for line, match := range matches {
-				matches_showed++
-				println("#", line, ":", strings.TrimSpace(match))
-				// Show max 10 matches
-				if matches_showed >= 10 {
-					fmt.Printf("... %v more matches\n", number_matches-matches_showed)
-					break
-				}
-			}
-		} else {
-			println("No matches found.")
+		// the value of a non boolean flag is the next argument
+		if f := fs.Lookup(name); f != nil && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			flags = append(flags, args[i])
 		}
-		println("> Time taken for < RegEx > matching :", time_after.Sub(time_before).Milliseconds(), "ms")
-	} else if app.algo == "kmp" {
-		// KMP
-		co := utils.CreateCarryOverTable(app.pattern)
-
-		// Matching
-		scanner := bufio.NewScanner(file)
-		time_before := time.Now()
-		matched, number_matches, matches := utils.KMPSearch(app.pattern, scanner, co)
-		time_after := time.Now()
-		if matched {
-			println("Matches found :", number_matches)
-			matches_showed := 0
+	}
 
-			for line, match := range matches {
-				matches_showed++
-				println("#", line, ":", strings.TrimSpace(match))
-				// Show max 10 matches
-				if matches_showed >= 10 {
-					fmt.Printf("... %v more matches\n", number_matches-matches_showed)
-					break
-				}
-			}
-		} else {
-			println("No matches found.")
+	if err := fs.Parse(flags); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, err
 		}
-		println("> Time taken for < KMP > matching :", time_after.Sub(time_before).Milliseconds(), "ms")
-
+		return nil, errReported // shown by fs with the usage
+	}
+	if len(positional) < min || len(positional) > max {
+		println("Wrong number of arguments.")
+		println("")
+		fs.Usage()
+		return nil, errReported
 	}
+	return positional, nil
+}
 
-	// Serve
-	//e := echo.New()
-	//e.GET("/", func(c echo.Context) error {
-	//	return c.String(200, "Hello, World!")
-	//})
-	//e.Logger.Fatal(e.Start(":9111"))
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
 }
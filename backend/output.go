@@ -0,0 +1,167 @@
+package main
+
+import (
+	"backend_main/utils"
+	"time"
+)
+
+// JSON shapes returned by the search server, and by search -format json
+type matchSpan struct {
+	Start int    `json:"start"` // rune offsets in the line text
+	End   int    `json:"end"`
+	Text  string `json:"text"` // the matched text
+}
+
+type contextLine struct {
+	Line int    `json:"line"`
+	Text string `json:"text"`
+}
+
+type searchMatch struct {
+	Line    int           `json:"line"`
+	Text    string        `json:"text"`
+	Book    string        `json:"book"`
+	Title   string        `json:"title"`
+	File    string        `json:"file,omitempty"` // book path, command line only
+	Matches []matchSpan   `json:"matches"`
+	Before  []contextLine `json:"before,omitempty"` // context lines, when asked
+	After   []contextLine `json:"after,omitempty"`
+}
+
+type rankedBook struct {
+	Book  string  `json:"book"`
+	Title string  `json:"title"`
+	Count int     `json:"count"`
+	Lines int     `json:"lines"` // matching lines
+	Score float64 `json:"score"`
+}
+
+type suggestedBook struct {
+	Book       string  `json:"book"`
+	Title      string  `json:"title"`
+	Similarity float64 `json:"similarity"`
+}
+
+type searchResponse struct {
+	Pattern string        `json:"pattern"`
+	Algo    string        `json:"algo"`
+	Rank    string        `json:"rank"`
+	Mode    string        `json:"mode"`
+	ICase   bool          `json:"ignore_case"`
+	IAccent bool          `json:"ignore_accents"`
+	Books   int           `json:"books"`   // number of books searched
+	Scanned int           `json:"scanned"` // books left to scan after the index lookup
+	Count   int           `json:"count"`   // occurrences in all matching lines
+	Lines   int           `json:"lines"`   // matching lines
+	Max     int           `json:"max"`     // matching lines listed per book, 0 for all
+	TimeMs  int64         `json:"time_ms"`
+	Ranking []rankedBook  `json:"ranking"` // matching books, most relevant first
+	Matches []searchMatch `json:"matches"` // in ranking order, at most Max per book
+
+	Truncated bool `json:"truncated"` // some matching lines are not listed
+
+	Suggestions []suggestedBook `json:"suggestions"` // related books from the index
+}
+
+// NDJSON lines of search -format ndjson : one matchLine per occurrence,
+// written as soon as its book is scanned, then a statsLine.
+type matchLine struct {
+	Type  string `json:"type"` // "match"
+	File  string `json:"file"`
+	Book  string `json:"book"`
+	Title string `json:"title"`
+	Line  int    `json:"line"`
+	Start int    `json:"start"` // rune offsets in line_text
+	End   int    `json:"end"`
+	Text  string `json:"text"` // the matched text
+	Full  string `json:"line_text"`
+}
+
+type statsLine struct {
+	Type    string `json:"type"` // "stats"
+	Pattern string `json:"pattern"`
+	Algo    string `json:"algo"`
+	Books   int    `json:"books"`
+	Scanned int    `json:"scanned"`
+	Count   int    `json:"count"`
+	Lines   int    `json:"lines"`
+	TimeMs  int64  `json:"time_ms"`
+}
+
+// newSearchResponse describes the ranked results of a search.
+func newSearchResponse(s *searcher, rank string, books int, scanned int, elapsed time.Duration, results []bookResult) searchResponse {
+	resp := searchResponse{
+		Pattern: s.pattern,
+		Algo:    s.algo,
+		Rank:    rank,
+		Mode:    s.opts.Mode.String(),
+		ICase:   s.opts.IgnoreCase,
+		IAccent: s.opts.IgnoreAccents,
+		Books:   books,
+		Scanned: scanned,
+		Max:     s.maxLines,
+		TimeMs:  elapsed.Milliseconds(),
+		Ranking: []rankedBook{},
+		Matches: []searchMatch{},
+
+		Suggestions: []suggestedBook{},
+	}
+	for _, result := range results {
+		resp.Ranking = append(resp.Ranking, rankedBook{
+			Book:  result.Book.ID,
+			Title: result.Book.Title,
+			Count: result.Count,
+			Lines: result.Matching,
+			Score: result.Score,
+		})
+		resp.Count += result.Count
+		resp.Lines += result.Matching
+		resp.Truncated = resp.Truncated || len(result.Matches) < result.Matching
+		for _, match := range result.Matches {
+			resp.Matches = append(resp.Matches, searchMatch{
+				Line:    match.Line,
+				Text:    match.Text,
+				Book:    result.Book.ID,
+				Title:   result.Book.Title,
+				Matches: matchSpans(match),
+				Before:  contextLines(match.Before),
+				After:   contextLines(match.After),
+			})
+		}
+	}
+	return resp
+}
+
+// matchSpans lists the occurrences of a matching line with their text.
+func matchSpans(match utils.LineMatch) []matchSpan {
+	runes := []rune(match.Text)
+	spans := make([]matchSpan, len(match.Matches))
+	for i, m := range match.Matches {
+		spans[i] = matchSpan{Start: m.Start, End: m.End, Text: string(runes[m.Start:m.End])}
+	}
+	return spans
+}
+
+func contextLines(lines []utils.ContextLine) []contextLine {
+	if len(lines) == 0 {
+		return nil
+	}
+	context := make([]contextLine, len(lines))
+	for i, line := range lines {
+		context[i] = contextLine{Line: line.Line, Text: line.Text}
+	}
+	return context
+}
+
+// suggestedBooks lists up to k books related to the results in the index.
+func suggestedBooks(s *searcher, results []bookResult, k int) []suggestedBook {
+	suggestions := []suggestedBook{}
+	for _, suggestion := range s.suggestions(results, k) {
+		suggestions = append(suggestions, suggestedBook{
+			Book:       suggestion.Book,
+			Title:      s.index.Books[suggestion.Book].Title,
+			Similarity: suggestion.Similarity,
+		})
+	}
+	return suggestions
+}
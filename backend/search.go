@@ -0,0 +1,271 @@
+package main
+
+import (
+	"backend_main/utils"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// bookResult holds the matching lines of one book, by line number.
+type bookResult struct {
+	Book     utils.Book
+	Count    int     // occurrences of the pattern
+	Lines    int     // lines read in the book
+	Matching int     // matching lines, Matches may keep fewer of them
+	Score    float64 // relevance, see rankResults
+	Matches  []utils.LineMatch
+}
+
+// cancelReader stops reading once its context is cancelled, so that the
+// scan of a large book ends early.
+type cancelReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c cancelReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
+
+// cancelReaderAt is cancelReader for the chunks of a file, see
+// utils.Matcher.ScanAt.
+type cancelReaderAt struct {
+	ctx context.Context
+	r   io.ReaderAt
+}
+
+func (c cancelReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.ReadAt(p, off)
+}
+
+// searcher runs one compiled pattern over any number of books.
+type searcher struct {
+	pattern  string
+	algo     string // regex or kmp
+	opts     utils.Options
+	matcher  utils.Matcher
+	index    *utils.Index // optional, used to skip books
+	maxLines int          // matching lines kept per book, all of them when 0
+	workers  int          // books searched at the same time, GOMAXPROCS when 0
+	chunks   int          // parts of a book file scanned at the same time, when more than 1
+}
+
+// newSearcher compiles the pattern, opts.Algo being auto, regex or kmp.
+func newSearcher(pattern string, opts utils.Options) (*searcher, error) {
+	if opts.Algo == "" || opts.Algo == utils.AlgoAuto {
+		opts.Algo = utils.DetectAlgo(pattern)
+	}
+	matcher, err := utils.Compile(pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &searcher{pattern: pattern, algo: opts.Algo, opts: opts, matcher: matcher}, nil
+}
+
+func (s *searcher) searchBook(ctx context.Context, book utils.Book) (bookResult, error) {
+	if s.chunks > 1 && book.Path != "" {
+		return s.searchChunks(ctx, book)
+	}
+	r, err := book.Open()
+	if err != nil {
+		return bookResult{}, err
+	}
+	defer r.Close()
+
+	number_matches, matches, lines, err := s.matcher.Scan(cancelReader{ctx: ctx, r: r})
+	if err != nil {
+		return bookResult{}, fmt.Errorf("reading %s: %w", book.ID, err)
+	}
+	return s.newResult(book, number_matches, lines, matches), nil
+}
+
+// candidates returns the books that may contain the pattern according to
+// the index, looking up the literal fragments of the pattern. Books missing
+// from the index or changed since it was built are always kept, and without
+// an index every book has to be scanned.
+func (s *searcher) candidates(books []utils.Book) []utils.Book {
+	if s.index == nil {
+		return books
+	}
+	found, ok := s.index.CandidateBooks(s.matcher.Literals(), s.opts.IgnoreAccents)
+	if !ok {
+		return books
+	}
+	kept := []utils.Book{}
+	for _, book := range books {
+		if _, in := found[book.ID]; in || !s.index.Covers(book) {
+			kept = append(kept, book)
+		}
+	}
+	return kept
+}
+
+// searchChunks searches a book file split in s.chunks parts, aligned on
+// lines and scanned at the same time.
+func (s *searcher) searchChunks(ctx context.Context, book utils.Book) (bookResult, error) {
+	f, err := os.Open(book.Path)
+	if err != nil {
+		return bookResult{}, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return bookResult{}, err
+	}
+
+	number_matches, matches, lines, err := s.matcher.ScanAt(cancelReaderAt{ctx: ctx, r: f}, info.Size(), s.chunks)
+	if err != nil {
+		return bookResult{}, fmt.Errorf("reading %s: %w", book.ID, err)
+	}
+	return s.newResult(book, number_matches, lines, matches), nil
+}
+
+// newResult keeps the first s.maxLines matching lines of a book, so that
+// the other ones are freed as soon as the book is searched.
+func (s *searcher) newResult(book utils.Book, number_matches int, lines int, matches []utils.LineMatch) bookResult {
+	result := bookResult{Book: book, Count: number_matches, Lines: lines, Matching: len(matches), Matches: matches}
+	if s.maxLines > 0 && len(matches) > s.maxLines {
+		result.Matches = slices.Clone(matches[:s.maxLines])
+	}
+	return result
+}
+
+// bookSearch is the outcome of the search of one book, see searchParallel.
+type bookSearch struct {
+	index  int // of the book in the searched books
+	result bookResult
+	err    error
+}
+
+// searchParallel searches the books with s.workers goroutines sharing the
+// compiled pattern, and sends the outcome of every book on the returned
+// channel as soon as it is searched, in no particular order. The channel is
+// closed once every book is searched, or early when ctx is cancelled.
+func (s *searcher) searchParallel(ctx context.Context, books []utils.Book) <-chan bookSearch {
+	workers := workerCount(s.workers)
+	jobs := make(chan int)
+	out := make(chan bookSearch)
+
+	go func() {
+		defer close(jobs)
+		for i := range books {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				result, err := s.searchBook(ctx, books[i])
+				select {
+				case out <- bookSearch{index: i, result: result, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// workerCount is the number of goroutines searching books, GOMAXPROCS
+// unless workers is set.
+func workerCount(workers int) int {
+	if workers < 1 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return workers
+}
+
+// searchBooks searches every book in parallel and keeps the ones that
+// matched, in the order of books. The first error stops the search.
+func (s *searcher) searchBooks(ctx context.Context, books []utils.Book) ([]bookResult, error) {
+	search_ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	found := make([]*bookResult, len(books))
+	var first_err error
+	for searched := range s.searchParallel(search_ctx, books) {
+		if searched.err != nil {
+			if first_err == nil {
+				first_err = searched.err
+				cancel()
+			}
+			continue
+		}
+		if searched.result.Count > 0 {
+			found[searched.index] = &searched.result
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err // cancelled by the caller
+	}
+	if first_err != nil {
+		return nil, first_err
+	}
+
+	results := []bookResult{}
+	for _, result := range found {
+		if result != nil {
+			results = append(results, *result)
+		}
+	}
+	return results, nil
+}
+
+// rankResults orders the results by relevance. searched is the number of
+// books the query ran against, including the ones skipped by the index.
+func (s *searcher) rankResults(results []bookResult, method string, searched int) ([]bookResult, error) {
+	hits := make([]utils.BookHits, len(results))
+	by_id := make(map[string]bookResult, len(results))
+	for i, result := range results {
+		hits[i] = utils.BookHits{Book: result.Book.ID, Matches: result.Count, Lines: result.Lines}
+		by_id[result.Book.ID] = result
+	}
+
+	ranked, err := utils.RankBooks(method, hits, searched, s.index)
+	if err != nil {
+		return nil, err
+	}
+	ordered := make([]bookResult, len(ranked))
+	for i, r := range ranked {
+		ordered[i] = by_id[r.Book]
+		ordered[i].Score = r.Score
+	}
+	return ordered, nil
+}
+
+// suggestions returns books related to the results from the similarity
+// graph of the index, nil without an index.
+func (s *searcher) suggestions(results []bookResult, k int) []utils.Neighbor {
+	if s.index == nil || len(results) == 0 {
+		return nil
+	}
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result.Book.ID
+	}
+	return s.index.Graph().Suggest(ids, k)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"backend_main/utils"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testBooks returns n books, the even ones containing "Sargon" once per
+// line on i lines.
+func testBooks(n int) []utils.Book {
+	books := []utils.Book{}
+	for i := 0; i < n; i++ {
+		text := strings.Repeat("nothing here\n", 3)
+		if i%2 == 0 {
+			text += strings.Repeat("Sargon of Akkad\n", i)
+		}
+		books = append(books, utils.Book{ID: fmt.Sprint(i), Text: text})
+	}
+	return books
+}
+
+func TestSearchBooks(t *testing.T) {
+	for _, workers := range []int{1, 4} {
+		s, err := newSearcher("Sarg(o|a)n", utils.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.workers = workers
+		results, err := s.searchBooks(context.Background(), testBooks(20))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// book 0 has no line with Sargon
+		if len(results) != 9 {
+			t.Fatalf("%d workers : %d results, want 9", workers, len(results))
+		}
+		for k, result := range results {
+			i := 2 * (k + 1)
+			if result.Book.ID != fmt.Sprint(i) || result.Count != i || result.Lines != 3+i {
+				t.Errorf("%d workers : result %d = book %s, %d matches in %d lines", workers, k, result.Book.ID, result.Count, result.Lines)
+			}
+		}
+	}
+}
+
+func TestSearchBooksCancelled(t *testing.T) {
+	s, err := newSearcher("Sargon", utils.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if results, err := s.searchBooks(ctx, testBooks(20)); !errors.Is(err, context.Canceled) || results != nil {
+		t.Errorf("cancelled before the search : got %d results, error %v", len(results), err)
+	}
+
+	// cancelled while a large book is scanned
+	large := []utils.Book{{ID: "large", Text: strings.Repeat("Sargon of Akkad\n", 2_000_000)}}
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if results, err := s.searchBooks(ctx, large); !errors.Is(err, context.Canceled) || results != nil {
+		t.Errorf("cancelled during the search : got %d results, error %v", len(results), err)
+	}
+}
+
+func TestSearchBooksFirstError(t *testing.T) {
+	s, err := newSearcher("Sargon", utils.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.workers = 2
+	books := testBooks(10)
+	missing := utils.Book{ID: "missing", Path: filepath.Join(t.TempDir(), "missing.txt")}
+	books = append(books[:5], append([]utils.Book{missing}, books[5:]...)...)
+	results, err := s.searchBooks(context.Background(), books)
+	if !errors.Is(err, fs.ErrNotExist) || results != nil {
+		t.Errorf("got %d results, error %v, want the error of the missing book", len(results), err)
+	}
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"backend_main/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Bounds of the parameters of a request
+const (
+	maxContext      = 100  // context lines
+	defaultMaxLines = 10   // matching lines listed per book
+	maxMaxLines     = 1000 // largest 'max'
+)
+
+// Timeouts of the server. A search running longer than searchTimeout is
+// cancelled and answered with 503.
+const (
+	readTimeout   = 10 * time.Second
+	searchTimeout = 30 * time.Second
+	writeTimeout  = searchTimeout + 5*time.Second
+	idleTimeout   = 60 * time.Second
+)
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type server struct {
+	books []utils.Book // loaded once, never modified
+	index *utils.Index // nil when the corpus was not indexed
+}
+
+func (s *server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "only GET is allowed"})
+		return
+	}
+	query := r.URL.Query()
+
+	pattern := query.Get("pattern")
+	if pattern == "" {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "missing 'pattern' parameter"})
+		return
+	}
+	algo := query.Get("algo")
+	if algo == "" {
+		algo = utils.AlgoAuto // resolved by newSearcher
+	}
+	if algo != utils.AlgoAuto && algo != utils.AlgoRegex && algo != utils.AlgoKMP {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "'algo' must be 'auto', 'regex' or 'kmp'"})
+		return
+	}
+	rank := query.Get("rank")
+	if rank == "" {
+		rank = utils.RankMatches
+	}
+	mode := utils.LeftmostLongest
+	if query.Get("mode") != "" {
+		var err error
+		mode, err = utils.ParseMatchMode(query.Get("mode"))
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	ignore_case, ok := boolParam(w, query, "ignore_case")
+	if !ok {
+		return
+	}
+	ignore_accents, ok := boolParam(w, query, "ignore_accents")
+	if !ok {
+		return
+	}
+	context_lines, ok := intParam(w, query, "context", 0, 0, maxContext)
+	if !ok {
+		return
+	}
+	max_lines, ok := intParam(w, query, "max", defaultMaxLines, 1, maxMaxLines)
+	if !ok {
+		return
+	}
+
+	// without a book id the whole corpus is searched
+	books := s.books
+	if book := query.Get("book"); book != "" {
+		selected := []utils.Book{}
+		for _, b := range books {
+			if b.ID == book {
+				selected = append(selected, b)
+			}
+		}
+		if len(selected) == 0 {
+			writeJSON(w, http.StatusNotFound, errorResponse{Error: "unknown book '" + book + "'"})
+			return
+		}
+		books = selected
+	}
+
+	time_before := time.Now()
+	sr, err := newSearcher(pattern, utils.Options{
+		Algo:          algo,
+		Mode:          mode,
+		IgnoreCase:    ignore_case,
+		IgnoreAccents: ignore_accents,
+		Context:       utils.ContextSize{Before: context_lines, After: context_lines},
+	})
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+	sr.index = s.index
+	if err := utils.CheckRankMethod(rank, sr.index); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+	sr.maxLines = max_lines
+	candidates := sr.candidates(books)
+	ctx, cancel := context.WithTimeout(r.Context(), searchTimeout)
+	defer cancel()
+	results, err := sr.searchBooks(ctx, candidates)
+	time_after := time.Now()
+	if errors.Is(err, context.DeadlineExceeded) {
+		writeJSON(w, http.StatusServiceUnavailable, errorResponse{Error: fmt.Sprintf("search timed out after %v", searchTimeout)})
+		return
+	}
+	if errors.Is(err, context.Canceled) {
+		return // the client went away
+	}
+	if err != nil {
+		log.Println("searching:", err)
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "could not read books"})
+		return
+	}
+	results, err = sr.rankResults(results, rank, len(books))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+
+	resp := newSearchResponse(sr, rank, len(books), len(candidates), time_after.Sub(time_before), results)
+	resp.Suggestions = suggestedBooks(sr, results, 10)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// boolParam reads an optional true/false parameter, answering 400 when it
+// is invalid.
+func boolParam(w http.ResponseWriter, query url.Values, name string) (value bool, ok bool) {
+	if query.Get(name) == "" {
+		return false, true
+	}
+	value, err := strconv.ParseBool(query.Get(name))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "'" + name + "' must be true or false"})
+		return false, false
+	}
+	return value, true
+}
+
+// intParam reads an optional number between min and max, def when it is
+// missing, answering 400 when it is invalid.
+func intParam(w http.ResponseWriter, query url.Values, name string, def int, min int, max int) (value int, ok bool) {
+	if query.Get(name) == "" {
+		return def, true
+	}
+	value, err := strconv.Atoi(query.Get(name))
+	if err != nil || value < min || value > max {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("'%s' must be a number between %d and %d", name, min, max)})
+		return 0, false
+	}
+	return value, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println("writing response:", err)
+	}
+}
+
+// serve starts the HTTP search server on addr, serving the books found at
+// corpus. Books are loaded once, with their text for a books.json.
+func serve(addr string, corpus string) error {
+	books, err := utils.LoadCorpus(corpus)
+	if err != nil {
+		return err
+	}
+	s := &server{books: books}
+	index_path := utils.DefaultIndexPath(corpus)
+	if _, err := os.Stat(index_path); err == nil {
+		s.index, err = utils.LoadIndex(index_path)
+		if err != nil {
+			return err
+		}
+		log.Printf("Using index %s (%d books)", index_path, len(s.index.Books))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", s.handleSearch)
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+	log.Printf("Serving %d books from %s on %s", len(books), corpus, addr)
+	return srv.ListenAndServe()
+}
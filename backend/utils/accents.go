@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate python3 gen_accents.py
+//go:generate gofmt -w accents_table.go
+
+// StripAccents removes the diacritics of s : accented letters are replaced
+// by their base letter (see accentBase) and combining marks are dropped,
+// like NFD followed by the removal of marks. Letters which do not
+// decompose, like ø, đ, æ or œ, are kept. offsets maps the runes of the
+// result to the runes of s, offsets[i] being the index in s of the i-th
+// rune of the result and offsets[len(result)] the length of s. offsets is
+// nil when s is unchanged.
+func StripAccents(s string) (stripped string, offsets []int) {
+	if !hasAccents(s) {
+		return s, nil
+	}
+	runes := []rune(s)
+	out := make([]rune, 0, len(runes))
+	offsets = make([]int, 0, len(runes)+1)
+	for i, r := range runes {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if base, ok := accentBase[r]; ok {
+			r = base
+		}
+		out = append(out, r)
+		offsets = append(offsets, i)
+	}
+	return string(out), append(offsets, len(runes))
+}
+
+func hasAccents(s string) bool {
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			continue
+		}
+		if _, ok := accentBase[r]; ok || unicode.Is(unicode.Mn, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// originalMatches maps matches found in a stripped line back to the runes
+// of the original line, see StripAccents. The marks following the last
+// letter of a match are part of it.
+func originalMatches(matches []Match, offsets []int) []Match {
+	if offsets == nil {
+		return matches
+	}
+	for i, m := range matches {
+		matches[i] = Match{Start: offsets[m.Start], End: offsets[m.End]}
+	}
+	return matches
+}
+
+// StripAccents makes the tree match the text returned by StripAccents : its
+// accented letters are replaced by their base letter, its combining marks
+// match the empty string, and the classes holding accented letters also
+// hold their base letter, so [à-ÿ] keeps its range and matches the "e" of
+// a stripped "é". The tree is stripped after parsing, not the pattern
+// text, so that class bounds and error columns are those of the pattern.
+func (n *RegexTreeNode) StripAccents() {
+	if n == nil {
+		return
+	}
+	switch n.operation {
+	case "atom":
+		if unicode.Is(unicode.Mn, n.value) {
+			n.operation, n.value = "empty", 0
+		} else if base, ok := accentBase[n.value]; ok {
+			n.value = base
+		}
+	case "charset":
+		n.class.ranges = stripRanges(n.class.ranges)
+	}
+	n.left.StripAccents()
+	n.right.StripAccents()
+}
+
+// stripRanges adds to the normalized ranges the base letter of their
+// accented letters.
+func stripRanges(ranges []runeRange) []runeRange {
+	stripped := append([]runeRange{}, ranges...)
+	for r, base := range accentBase {
+		if inRanges(ranges, r) {
+			stripped = append(stripped, runeRange{base, base})
+		}
+	}
+	return normalizeRanges(stripped)
+}
@@ -0,0 +1,2008 @@
+// Code generated by gen_accents.py from the Unicode 14.0.0 decomposition data. DO NOT EDIT.
+
+package utils
+
+// accentBase maps the runes whose canonical decomposition is a base rune
+// followed by combining marks to that base rune, e.g. é to e.
+var accentBase = map[rune]rune{
+	0x00C0:  0x0041,  // LATIN CAPITAL LETTER A WITH GRAVE
+	0x00C1:  0x0041,  // LATIN CAPITAL LETTER A WITH ACUTE
+	0x00C2:  0x0041,  // LATIN CAPITAL LETTER A WITH CIRCUMFLEX
+	0x00C3:  0x0041,  // LATIN CAPITAL LETTER A WITH TILDE
+	0x00C4:  0x0041,  // LATIN CAPITAL LETTER A WITH DIAERESIS
+	0x00C5:  0x0041,  // LATIN CAPITAL LETTER A WITH RING ABOVE
+	0x00C7:  0x0043,  // LATIN CAPITAL LETTER C WITH CEDILLA
+	0x00C8:  0x0045,  // LATIN CAPITAL LETTER E WITH GRAVE
+	0x00C9:  0x0045,  // LATIN CAPITAL LETTER E WITH ACUTE
+	0x00CA:  0x0045,  // LATIN CAPITAL LETTER E WITH CIRCUMFLEX
+	0x00CB:  0x0045,  // LATIN CAPITAL LETTER E WITH DIAERESIS
+	0x00CC:  0x0049,  // LATIN CAPITAL LETTER I WITH GRAVE
+	0x00CD:  0x0049,  // LATIN CAPITAL LETTER I WITH ACUTE
+	0x00CE:  0x0049,  // LATIN CAPITAL LETTER I WITH CIRCUMFLEX
+	0x00CF:  0x0049,  // LATIN CAPITAL LETTER I WITH DIAERESIS
+	0x00D1:  0x004E,  // LATIN CAPITAL LETTER N WITH TILDE
+	0x00D2:  0x004F,  // LATIN CAPITAL LETTER O WITH GRAVE
+	0x00D3:  0x004F,  // LATIN CAPITAL LETTER O WITH ACUTE
+	0x00D4:  0x004F,  // LATIN CAPITAL LETTER O WITH CIRCUMFLEX
+	0x00D5:  0x004F,  // LATIN CAPITAL LETTER O WITH TILDE
+	0x00D6:  0x004F,  // LATIN CAPITAL LETTER O WITH DIAERESIS
+	0x00D9:  0x0055,  // LATIN CAPITAL LETTER U WITH GRAVE
+	0x00DA:  0x0055,  // LATIN CAPITAL LETTER U WITH ACUTE
+	0x00DB:  0x0055,  // LATIN CAPITAL LETTER U WITH CIRCUMFLEX
+	0x00DC:  0x0055,  // LATIN CAPITAL LETTER U WITH DIAERESIS
+	0x00DD:  0x0059,  // LATIN CAPITAL LETTER Y WITH ACUTE
+	0x00E0:  0x0061,  // LATIN SMALL LETTER A WITH GRAVE
+	0x00E1:  0x0061,  // LATIN SMALL LETTER A WITH ACUTE
+	0x00E2:  0x0061,  // LATIN SMALL LETTER A WITH CIRCUMFLEX
+	0x00E3:  0x0061,  // LATIN SMALL LETTER A WITH TILDE
+	0x00E4:  0x0061,  // LATIN SMALL LETTER A WITH DIAERESIS
+	0x00E5:  0x0061,  // LATIN SMALL LETTER A WITH RING ABOVE
+	0x00E7:  0x0063,  // LATIN SMALL LETTER C WITH CEDILLA
+	0x00E8:  0x0065,  // LATIN SMALL LETTER E WITH GRAVE
+	0x00E9:  0x0065,  // LATIN SMALL LETTER E WITH ACUTE
+	0x00EA:  0x0065,  // LATIN SMALL LETTER E WITH CIRCUMFLEX
+	0x00EB:  0x0065,  // LATIN SMALL LETTER E WITH DIAERESIS
+	0x00EC:  0x0069,  // LATIN SMALL LETTER I WITH GRAVE
+	0x00ED:  0x0069,  // LATIN SMALL LETTER I WITH ACUTE
+	0x00EE:  0x0069,  // LATIN SMALL LETTER I WITH CIRCUMFLEX
+	0x00EF:  0x0069,  // LATIN SMALL LETTER I WITH DIAERESIS
+	0x00F1:  0x006E,  // LATIN SMALL LETTER N WITH TILDE
+	0x00F2:  0x006F,  // LATIN SMALL LETTER O WITH GRAVE
+	0x00F3:  0x006F,  // LATIN SMALL LETTER O WITH ACUTE
+	0x00F4:  0x006F,  // LATIN SMALL LETTER O WITH CIRCUMFLEX
+	0x00F5:  0x006F,  // LATIN SMALL LETTER O WITH TILDE
+	0x00F6:  0x006F,  // LATIN SMALL LETTER O WITH DIAERESIS
+	0x00F9:  0x0075,  // LATIN SMALL LETTER U WITH GRAVE
+	0x00FA:  0x0075,  // LATIN SMALL LETTER U WITH ACUTE
+	0x00FB:  0x0075,  // LATIN SMALL LETTER U WITH CIRCUMFLEX
+	0x00FC:  0x0075,  // LATIN SMALL LETTER U WITH DIAERESIS
+	0x00FD:  0x0079,  // LATIN SMALL LETTER Y WITH ACUTE
+	0x00FF:  0x0079,  // LATIN SMALL LETTER Y WITH DIAERESIS
+	0x0100:  0x0041,  // LATIN CAPITAL LETTER A WITH MACRON
+	0x0101:  0x0061,  // LATIN SMALL LETTER A WITH MACRON
+	0x0102:  0x0041,  // LATIN CAPITAL LETTER A WITH BREVE
+	0x0103:  0x0061,  // LATIN SMALL LETTER A WITH BREVE
+	0x0104:  0x0041,  // LATIN CAPITAL LETTER A WITH OGONEK
+	0x0105:  0x0061,  // LATIN SMALL LETTER A WITH OGONEK
+	0x0106:  0x0043,  // LATIN CAPITAL LETTER C WITH ACUTE
+	0x0107:  0x0063,  // LATIN SMALL LETTER C WITH ACUTE
+	0x0108:  0x0043,  // LATIN CAPITAL LETTER C WITH CIRCUMFLEX
+	0x0109:  0x0063,  // LATIN SMALL LETTER C WITH CIRCUMFLEX
+	0x010A:  0x0043,  // LATIN CAPITAL LETTER C WITH DOT ABOVE
+	0x010B:  0x0063,  // LATIN SMALL LETTER C WITH DOT ABOVE
+	0x010C:  0x0043,  // LATIN CAPITAL LETTER C WITH CARON
+	0x010D:  0x0063,  // LATIN SMALL LETTER C WITH CARON
+	0x010E:  0x0044,  // LATIN CAPITAL LETTER D WITH CARON
+	0x010F:  0x0064,  // LATIN SMALL LETTER D WITH CARON
+	0x0112:  0x0045,  // LATIN CAPITAL LETTER E WITH MACRON
+	0x0113:  0x0065,  // LATIN SMALL LETTER E WITH MACRON
+	0x0114:  0x0045,  // LATIN CAPITAL LETTER E WITH BREVE
+	0x0115:  0x0065,  // LATIN SMALL LETTER E WITH BREVE
+	0x0116:  0x0045,  // LATIN CAPITAL LETTER E WITH DOT ABOVE
+	0x0117:  0x0065,  // LATIN SMALL LETTER E WITH DOT ABOVE
+	0x0118:  0x0045,  // LATIN CAPITAL LETTER E WITH OGONEK
+	0x0119:  0x0065,  // LATIN SMALL LETTER E WITH OGONEK
+	0x011A:  0x0045,  // LATIN CAPITAL LETTER E WITH CARON
+	0x011B:  0x0065,  // LATIN SMALL LETTER E WITH CARON
+	0x011C:  0x0047,  // LATIN CAPITAL LETTER G WITH CIRCUMFLEX
+	0x011D:  0x0067,  // LATIN SMALL LETTER G WITH CIRCUMFLEX
+	0x011E:  0x0047,  // LATIN CAPITAL LETTER G WITH BREVE
+	0x011F:  0x0067,  // LATIN SMALL LETTER G WITH BREVE
+	0x0120:  0x0047,  // LATIN CAPITAL LETTER G WITH DOT ABOVE
+	0x0121:  0x0067,  // LATIN SMALL LETTER G WITH DOT ABOVE
+	0x0122:  0x0047,  // LATIN CAPITAL LETTER G WITH CEDILLA
+	0x0123:  0x0067,  // LATIN SMALL LETTER G WITH CEDILLA
+	0x0124:  0x0048,  // LATIN CAPITAL LETTER H WITH CIRCUMFLEX
+	0x0125:  0x0068,  // LATIN SMALL LETTER H WITH CIRCUMFLEX
+	0x0128:  0x0049,  // LATIN CAPITAL LETTER I WITH TILDE
+	0x0129:  0x0069,  // LATIN SMALL LETTER I WITH TILDE
+	0x012A:  0x0049,  // LATIN CAPITAL LETTER I WITH MACRON
+	0x012B:  0x0069,  // LATIN SMALL LETTER I WITH MACRON
+	0x012C:  0x0049,  // LATIN CAPITAL LETTER I WITH BREVE
+	0x012D:  0x0069,  // LATIN SMALL LETTER I WITH BREVE
+	0x012E:  0x0049,  // LATIN CAPITAL LETTER I WITH OGONEK
+	0x012F:  0x0069,  // LATIN SMALL LETTER I WITH OGONEK
+	0x0130:  0x0049,  // LATIN CAPITAL LETTER I WITH DOT ABOVE
+	0x0134:  0x004A,  // LATIN CAPITAL LETTER J WITH CIRCUMFLEX
+	0x0135:  0x006A,  // LATIN SMALL LETTER J WITH CIRCUMFLEX
+	0x0136:  0x004B,  // LATIN CAPITAL LETTER K WITH CEDILLA
+	0x0137:  0x006B,  // LATIN SMALL LETTER K WITH CEDILLA
+	0x0139:  0x004C,  // LATIN CAPITAL LETTER L WITH ACUTE
+	0x013A:  0x006C,  // LATIN SMALL LETTER L WITH ACUTE
+	0x013B:  0x004C,  // LATIN CAPITAL LETTER L WITH CEDILLA
+	0x013C:  0x006C,  // LATIN SMALL LETTER L WITH CEDILLA
+	0x013D:  0x004C,  // LATIN CAPITAL LETTER L WITH CARON
+	0x013E:  0x006C,  // LATIN SMALL LETTER L WITH CARON
+	0x0143:  0x004E,  // LATIN CAPITAL LETTER N WITH ACUTE
+	0x0144:  0x006E,  // LATIN SMALL LETTER N WITH ACUTE
+	0x0145:  0x004E,  // LATIN CAPITAL LETTER N WITH CEDILLA
+	0x0146:  0x006E,  // LATIN SMALL LETTER N WITH CEDILLA
+	0x0147:  0x004E,  // LATIN CAPITAL LETTER N WITH CARON
+	0x0148:  0x006E,  // LATIN SMALL LETTER N WITH CARON
+	0x014C:  0x004F,  // LATIN CAPITAL LETTER O WITH MACRON
+	0x014D:  0x006F,  // LATIN SMALL LETTER O WITH MACRON
+	0x014E:  0x004F,  // LATIN CAPITAL LETTER O WITH BREVE
+	0x014F:  0x006F,  // LATIN SMALL LETTER O WITH BREVE
+	0x0150:  0x004F,  // LATIN CAPITAL LETTER O WITH DOUBLE ACUTE
+	0x0151:  0x006F,  // LATIN SMALL LETTER O WITH DOUBLE ACUTE
+	0x0154:  0x0052,  // LATIN CAPITAL LETTER R WITH ACUTE
+	0x0155:  0x0072,  // LATIN SMALL LETTER R WITH ACUTE
+	0x0156:  0x0052,  // LATIN CAPITAL LETTER R WITH CEDILLA
+	0x0157:  0x0072,  // LATIN SMALL LETTER R WITH CEDILLA
+	0x0158:  0x0052,  // LATIN CAPITAL LETTER R WITH CARON
+	0x0159:  0x0072,  // LATIN SMALL LETTER R WITH CARON
+	0x015A:  0x0053,  // LATIN CAPITAL LETTER S WITH ACUTE
+	0x015B:  0x0073,  // LATIN SMALL LETTER S WITH ACUTE
+	0x015C:  0x0053,  // LATIN CAPITAL LETTER S WITH CIRCUMFLEX
+	0x015D:  0x0073,  // LATIN SMALL LETTER S WITH CIRCUMFLEX
+	0x015E:  0x0053,  // LATIN CAPITAL LETTER S WITH CEDILLA
+	0x015F:  0x0073,  // LATIN SMALL LETTER S WITH CEDILLA
+	0x0160:  0x0053,  // LATIN CAPITAL LETTER S WITH CARON
+	0x0161:  0x0073,  // LATIN SMALL LETTER S WITH CARON
+	0x0162:  0x0054,  // LATIN CAPITAL LETTER T WITH CEDILLA
+	0x0163:  0x0074,  // LATIN SMALL LETTER T WITH CEDILLA
+	0x0164:  0x0054,  // LATIN CAPITAL LETTER T WITH CARON
+	0x0165:  0x0074,  // LATIN SMALL LETTER T WITH CARON
+	0x0168:  0x0055,  // LATIN CAPITAL LETTER U WITH TILDE
+	0x0169:  0x0075,  // LATIN SMALL LETTER U WITH TILDE
+	0x016A:  0x0055,  // LATIN CAPITAL LETTER U WITH MACRON
+	0x016B:  0x0075,  // LATIN SMALL LETTER U WITH MACRON
+	0x016C:  0x0055,  // LATIN CAPITAL LETTER U WITH BREVE
+	0x016D:  0x0075,  // LATIN SMALL LETTER U WITH BREVE
+	0x016E:  0x0055,  // LATIN CAPITAL LETTER U WITH RING ABOVE
+	0x016F:  0x0075,  // LATIN SMALL LETTER U WITH RING ABOVE
+	0x0170:  0x0055,  // LATIN CAPITAL LETTER U WITH DOUBLE ACUTE
+	0x0171:  0x0075,  // LATIN SMALL LETTER U WITH DOUBLE ACUTE
+	0x0172:  0x0055,  // LATIN CAPITAL LETTER U WITH OGONEK
+	0x0173:  0x0075,  // LATIN SMALL LETTER U WITH OGONEK
+	0x0174:  0x0057,  // LATIN CAPITAL LETTER W WITH CIRCUMFLEX
+	0x0175:  0x0077,  // LATIN SMALL LETTER W WITH CIRCUMFLEX
+	0x0176:  0x0059,  // LATIN CAPITAL LETTER Y WITH CIRCUMFLEX
+	0x0177:  0x0079,  // LATIN SMALL LETTER Y WITH CIRCUMFLEX
+	0x0178:  0x0059,  // LATIN CAPITAL LETTER Y WITH DIAERESIS
+	0x0179:  0x005A,  // LATIN CAPITAL LETTER Z WITH ACUTE
+	0x017A:  0x007A,  // LATIN SMALL LETTER Z WITH ACUTE
+	0x017B:  0x005A,  // LATIN CAPITAL LETTER Z WITH DOT ABOVE
+	0x017C:  0x007A,  // LATIN SMALL LETTER Z WITH DOT ABOVE
+	0x017D:  0x005A,  // LATIN CAPITAL LETTER Z WITH CARON
+	0x017E:  0x007A,  // LATIN SMALL LETTER Z WITH CARON
+	0x01A0:  0x004F,  // LATIN CAPITAL LETTER O WITH HORN
+	0x01A1:  0x006F,  // LATIN SMALL LETTER O WITH HORN
+	0x01AF:  0x0055,  // LATIN CAPITAL LETTER U WITH HORN
+	0x01B0:  0x0075,  // LATIN SMALL LETTER U WITH HORN
+	0x01CD:  0x0041,  // LATIN CAPITAL LETTER A WITH CARON
+	0x01CE:  0x0061,  // LATIN SMALL LETTER A WITH CARON
+	0x01CF:  0x0049,  // LATIN CAPITAL LETTER I WITH CARON
+	0x01D0:  0x0069,  // LATIN SMALL LETTER I WITH CARON
+	0x01D1:  0x004F,  // LATIN CAPITAL LETTER O WITH CARON
+	0x01D2:  0x006F,  // LATIN SMALL LETTER O WITH CARON
+	0x01D3:  0x0055,  // LATIN CAPITAL LETTER U WITH CARON
+	0x01D4:  0x0075,  // LATIN SMALL LETTER U WITH CARON
+	0x01D5:  0x0055,  // LATIN CAPITAL LETTER U WITH DIAERESIS AND MACRON
+	0x01D6:  0x0075,  // LATIN SMALL LETTER U WITH DIAERESIS AND MACRON
+	0x01D7:  0x0055,  // LATIN CAPITAL LETTER U WITH DIAERESIS AND ACUTE
+	0x01D8:  0x0075,  // LATIN SMALL LETTER U WITH DIAERESIS AND ACUTE
+	0x01D9:  0x0055,  // LATIN CAPITAL LETTER U WITH DIAERESIS AND CARON
+	0x01DA:  0x0075,  // LATIN SMALL LETTER U WITH DIAERESIS AND CARON
+	0x01DB:  0x0055,  // LATIN CAPITAL LETTER U WITH DIAERESIS AND GRAVE
+	0x01DC:  0x0075,  // LATIN SMALL LETTER U WITH DIAERESIS AND GRAVE
+	0x01DE:  0x0041,  // LATIN CAPITAL LETTER A WITH DIAERESIS AND MACRON
+	0x01DF:  0x0061,  // LATIN SMALL LETTER A WITH DIAERESIS AND MACRON
+	0x01E0:  0x0041,  // LATIN CAPITAL LETTER A WITH DOT ABOVE AND MACRON
+	0x01E1:  0x0061,  // LATIN SMALL LETTER A WITH DOT ABOVE AND MACRON
+	0x01E2:  0x00C6,  // LATIN CAPITAL LETTER AE WITH MACRON
+	0x01E3:  0x00E6,  // LATIN SMALL LETTER AE WITH MACRON
+	0x01E6:  0x0047,  // LATIN CAPITAL LETTER G WITH CARON
+	0x01E7:  0x0067,  // LATIN SMALL LETTER G WITH CARON
+	0x01E8:  0x004B,  // LATIN CAPITAL LETTER K WITH CARON
+	0x01E9:  0x006B,  // LATIN SMALL LETTER K WITH CARON
+	0x01EA:  0x004F,  // LATIN CAPITAL LETTER O WITH OGONEK
+	0x01EB:  0x006F,  // LATIN SMALL LETTER O WITH OGONEK
+	0x01EC:  0x004F,  // LATIN CAPITAL LETTER O WITH OGONEK AND MACRON
+	0x01ED:  0x006F,  // LATIN SMALL LETTER O WITH OGONEK AND MACRON
+	0x01EE:  0x01B7,  // LATIN CAPITAL LETTER EZH WITH CARON
+	0x01EF:  0x0292,  // LATIN SMALL LETTER EZH WITH CARON
+	0x01F0:  0x006A,  // LATIN SMALL LETTER J WITH CARON
+	0x01F4:  0x0047,  // LATIN CAPITAL LETTER G WITH ACUTE
+	0x01F5:  0x0067,  // LATIN SMALL LETTER G WITH ACUTE
+	0x01F8:  0x004E,  // LATIN CAPITAL LETTER N WITH GRAVE
+	0x01F9:  0x006E,  // LATIN SMALL LETTER N WITH GRAVE
+	0x01FA:  0x0041,  // LATIN CAPITAL LETTER A WITH RING ABOVE AND ACUTE
+	0x01FB:  0x0061,  // LATIN SMALL LETTER A WITH RING ABOVE AND ACUTE
+	0x01FC:  0x00C6,  // LATIN CAPITAL LETTER AE WITH ACUTE
+	0x01FD:  0x00E6,  // LATIN SMALL LETTER AE WITH ACUTE
+	0x01FE:  0x00D8,  // LATIN CAPITAL LETTER O WITH STROKE AND ACUTE
+	0x01FF:  0x00F8,  // LATIN SMALL LETTER O WITH STROKE AND ACUTE
+	0x0200:  0x0041,  // LATIN CAPITAL LETTER A WITH DOUBLE GRAVE
+	0x0201:  0x0061,  // LATIN SMALL LETTER A WITH DOUBLE GRAVE
+	0x0202:  0x0041,  // LATIN CAPITAL LETTER A WITH INVERTED BREVE
+	0x0203:  0x0061,  // LATIN SMALL LETTER A WITH INVERTED BREVE
+	0x0204:  0x0045,  // LATIN CAPITAL LETTER E WITH DOUBLE GRAVE
+	0x0205:  0x0065,  // LATIN SMALL LETTER E WITH DOUBLE GRAVE
+	0x0206:  0x0045,  // LATIN CAPITAL LETTER E WITH INVERTED BREVE
+	0x0207:  0x0065,  // LATIN SMALL LETTER E WITH INVERTED BREVE
+	0x0208:  0x0049,  // LATIN CAPITAL LETTER I WITH DOUBLE GRAVE
+	0x0209:  0x0069,  // LATIN SMALL LETTER I WITH DOUBLE GRAVE
+	0x020A:  0x0049,  // LATIN CAPITAL LETTER I WITH INVERTED BREVE
+	0x020B:  0x0069,  // LATIN SMALL LETTER I WITH INVERTED BREVE
+	0x020C:  0x004F,  // LATIN CAPITAL LETTER O WITH DOUBLE GRAVE
+	0x020D:  0x006F,  // LATIN SMALL LETTER O WITH DOUBLE GRAVE
+	0x020E:  0x004F,  // LATIN CAPITAL LETTER O WITH INVERTED BREVE
+	0x020F:  0x006F,  // LATIN SMALL LETTER O WITH INVERTED BREVE
+	0x0210:  0x0052,  // LATIN CAPITAL LETTER R WITH DOUBLE GRAVE
+	0x0211:  0x0072,  // LATIN SMALL LETTER R WITH DOUBLE GRAVE
+	0x0212:  0x0052,  // LATIN CAPITAL LETTER R WITH INVERTED BREVE
+	0x0213:  0x0072,  // LATIN SMALL LETTER R WITH INVERTED BREVE
+	0x0214:  0x0055,  // LATIN CAPITAL LETTER U WITH DOUBLE GRAVE
+	0x0215:  0x0075,  // LATIN SMALL LETTER U WITH DOUBLE GRAVE
+	0x0216:  0x0055,  // LATIN CAPITAL LETTER U WITH INVERTED BREVE
+	0x0217:  0x0075,  // LATIN SMALL LETTER U WITH INVERTED BREVE
+	0x0218:  0x0053,  // LATIN CAPITAL LETTER S WITH COMMA BELOW
+	0x0219:  0x0073,  // LATIN SMALL LETTER S WITH COMMA BELOW
+	0x021A:  0x0054,  // LATIN CAPITAL LETTER T WITH COMMA BELOW
+	0x021B:  0x0074,  // LATIN SMALL LETTER T WITH COMMA BELOW
+	0x021E:  0x0048,  // LATIN CAPITAL LETTER H WITH CARON
+	0x021F:  0x0068,  // LATIN SMALL LETTER H WITH CARON
+	0x0226:  0x0041,  // LATIN CAPITAL LETTER A WITH DOT ABOVE
+	0x0227:  0x0061,  // LATIN SMALL LETTER A WITH DOT ABOVE
+	0x0228:  0x0045,  // LATIN CAPITAL LETTER E WITH CEDILLA
+	0x0229:  0x0065,  // LATIN SMALL LETTER E WITH CEDILLA
+	0x022A:  0x004F,  // LATIN CAPITAL LETTER O WITH DIAERESIS AND MACRON
+	0x022B:  0x006F,  // LATIN SMALL LETTER O WITH DIAERESIS AND MACRON
+	0x022C:  0x004F,  // LATIN CAPITAL LETTER O WITH TILDE AND MACRON
+	0x022D:  0x006F,  // LATIN SMALL LETTER O WITH TILDE AND MACRON
+	0x022E:  0x004F,  // LATIN CAPITAL LETTER O WITH DOT ABOVE
+	0x022F:  0x006F,  // LATIN SMALL LETTER O WITH DOT ABOVE
+	0x0230:  0x004F,  // LATIN CAPITAL LETTER O WITH DOT ABOVE AND MACRON
+	0x0231:  0x006F,  // LATIN SMALL LETTER O WITH DOT ABOVE AND MACRON
+	0x0232:  0x0059,  // LATIN CAPITAL LETTER Y WITH MACRON
+	0x0233:  0x0079,  // LATIN SMALL LETTER Y WITH MACRON
+	0x0374:  0x02B9,  // GREEK NUMERAL SIGN
+	0x037E:  0x003B,  // GREEK QUESTION MARK
+	0x0385:  0x00A8,  // GREEK DIALYTIKA TONOS
+	0x0386:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH TONOS
+	0x0387:  0x00B7,  // GREEK ANO TELEIA
+	0x0388:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH TONOS
+	0x0389:  0x0397,  // GREEK CAPITAL LETTER ETA WITH TONOS
+	0x038A:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH TONOS
+	0x038C:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH TONOS
+	0x038E:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH TONOS
+	0x038F:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH TONOS
+	0x0390:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+	0x03AA:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH DIALYTIKA
+	0x03AB:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH DIALYTIKA
+	0x03AC:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH TONOS
+	0x03AD:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH TONOS
+	0x03AE:  0x03B7,  // GREEK SMALL LETTER ETA WITH TONOS
+	0x03AF:  0x03B9,  // GREEK SMALL LETTER IOTA WITH TONOS
+	0x03B0:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS
+	0x03CA:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DIALYTIKA
+	0x03CB:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DIALYTIKA
+	0x03CC:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH TONOS
+	0x03CD:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH TONOS
+	0x03CE:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH TONOS
+	0x03D3:  0x03D2,  // GREEK UPSILON WITH ACUTE AND HOOK SYMBOL
+	0x03D4:  0x03D2,  // GREEK UPSILON WITH DIAERESIS AND HOOK SYMBOL
+	0x0400:  0x0415,  // CYRILLIC CAPITAL LETTER IE WITH GRAVE
+	0x0401:  0x0415,  // CYRILLIC CAPITAL LETTER IO
+	0x0403:  0x0413,  // CYRILLIC CAPITAL LETTER GJE
+	0x0407:  0x0406,  // CYRILLIC CAPITAL LETTER YI
+	0x040C:  0x041A,  // CYRILLIC CAPITAL LETTER KJE
+	0x040D:  0x0418,  // CYRILLIC CAPITAL LETTER I WITH GRAVE
+	0x040E:  0x0423,  // CYRILLIC CAPITAL LETTER SHORT U
+	0x0419:  0x0418,  // CYRILLIC CAPITAL LETTER SHORT I
+	0x0439:  0x0438,  // CYRILLIC SMALL LETTER SHORT I
+	0x0450:  0x0435,  // CYRILLIC SMALL LETTER IE WITH GRAVE
+	0x0451:  0x0435,  // CYRILLIC SMALL LETTER IO
+	0x0453:  0x0433,  // CYRILLIC SMALL LETTER GJE
+	0x0457:  0x0456,  // CYRILLIC SMALL LETTER YI
+	0x045C:  0x043A,  // CYRILLIC SMALL LETTER KJE
+	0x045D:  0x0438,  // CYRILLIC SMALL LETTER I WITH GRAVE
+	0x045E:  0x0443,  // CYRILLIC SMALL LETTER SHORT U
+	0x0476:  0x0474,  // CYRILLIC CAPITAL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT
+	0x0477:  0x0475,  // CYRILLIC SMALL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT
+	0x04C1:  0x0416,  // CYRILLIC CAPITAL LETTER ZHE WITH BREVE
+	0x04C2:  0x0436,  // CYRILLIC SMALL LETTER ZHE WITH BREVE
+	0x04D0:  0x0410,  // CYRILLIC CAPITAL LETTER A WITH BREVE
+	0x04D1:  0x0430,  // CYRILLIC SMALL LETTER A WITH BREVE
+	0x04D2:  0x0410,  // CYRILLIC CAPITAL LETTER A WITH DIAERESIS
+	0x04D3:  0x0430,  // CYRILLIC SMALL LETTER A WITH DIAERESIS
+	0x04D6:  0x0415,  // CYRILLIC CAPITAL LETTER IE WITH BREVE
+	0x04D7:  0x0435,  // CYRILLIC SMALL LETTER IE WITH BREVE
+	0x04DA:  0x04D8,  // CYRILLIC CAPITAL LETTER SCHWA WITH DIAERESIS
+	0x04DB:  0x04D9,  // CYRILLIC SMALL LETTER SCHWA WITH DIAERESIS
+	0x04DC:  0x0416,  // CYRILLIC CAPITAL LETTER ZHE WITH DIAERESIS
+	0x04DD:  0x0436,  // CYRILLIC SMALL LETTER ZHE WITH DIAERESIS
+	0x04DE:  0x0417,  // CYRILLIC CAPITAL LETTER ZE WITH DIAERESIS
+	0x04DF:  0x0437,  // CYRILLIC SMALL LETTER ZE WITH DIAERESIS
+	0x04E2:  0x0418,  // CYRILLIC CAPITAL LETTER I WITH MACRON
+	0x04E3:  0x0438,  // CYRILLIC SMALL LETTER I WITH MACRON
+	0x04E4:  0x0418,  // CYRILLIC CAPITAL LETTER I WITH DIAERESIS
+	0x04E5:  0x0438,  // CYRILLIC SMALL LETTER I WITH DIAERESIS
+	0x04E6:  0x041E,  // CYRILLIC CAPITAL LETTER O WITH DIAERESIS
+	0x04E7:  0x043E,  // CYRILLIC SMALL LETTER O WITH DIAERESIS
+	0x04EA:  0x04E8,  // CYRILLIC CAPITAL LETTER BARRED O WITH DIAERESIS
+	0x04EB:  0x04E9,  // CYRILLIC SMALL LETTER BARRED O WITH DIAERESIS
+	0x04EC:  0x042D,  // CYRILLIC CAPITAL LETTER E WITH DIAERESIS
+	0x04ED:  0x044D,  // CYRILLIC SMALL LETTER E WITH DIAERESIS
+	0x04EE:  0x0423,  // CYRILLIC CAPITAL LETTER U WITH MACRON
+	0x04EF:  0x0443,  // CYRILLIC SMALL LETTER U WITH MACRON
+	0x04F0:  0x0423,  // CYRILLIC CAPITAL LETTER U WITH DIAERESIS
+	0x04F1:  0x0443,  // CYRILLIC SMALL LETTER U WITH DIAERESIS
+	0x04F2:  0x0423,  // CYRILLIC CAPITAL LETTER U WITH DOUBLE ACUTE
+	0x04F3:  0x0443,  // CYRILLIC SMALL LETTER U WITH DOUBLE ACUTE
+	0x04F4:  0x0427,  // CYRILLIC CAPITAL LETTER CHE WITH DIAERESIS
+	0x04F5:  0x0447,  // CYRILLIC SMALL LETTER CHE WITH DIAERESIS
+	0x04F8:  0x042B,  // CYRILLIC CAPITAL LETTER YERU WITH DIAERESIS
+	0x04F9:  0x044B,  // CYRILLIC SMALL LETTER YERU WITH DIAERESIS
+	0x0622:  0x0627,  // ARABIC LETTER ALEF WITH MADDA ABOVE
+	0x0623:  0x0627,  // ARABIC LETTER ALEF WITH HAMZA ABOVE
+	0x0624:  0x0648,  // ARABIC LETTER WAW WITH HAMZA ABOVE
+	0x0625:  0x0627,  // ARABIC LETTER ALEF WITH HAMZA BELOW
+	0x0626:  0x064A,  // ARABIC LETTER YEH WITH HAMZA ABOVE
+	0x06C0:  0x06D5,  // ARABIC LETTER HEH WITH YEH ABOVE
+	0x06C2:  0x06C1,  // ARABIC LETTER HEH GOAL WITH HAMZA ABOVE
+	0x06D3:  0x06D2,  // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE
+	0x0929:  0x0928,  // DEVANAGARI LETTER NNNA
+	0x0931:  0x0930,  // DEVANAGARI LETTER RRA
+	0x0934:  0x0933,  // DEVANAGARI LETTER LLLA
+	0x0958:  0x0915,  // DEVANAGARI LETTER QA
+	0x0959:  0x0916,  // DEVANAGARI LETTER KHHA
+	0x095A:  0x0917,  // DEVANAGARI LETTER GHHA
+	0x095B:  0x091C,  // DEVANAGARI LETTER ZA
+	0x095C:  0x0921,  // DEVANAGARI LETTER DDDHA
+	0x095D:  0x0922,  // DEVANAGARI LETTER RHA
+	0x095E:  0x092B,  // DEVANAGARI LETTER FA
+	0x095F:  0x092F,  // DEVANAGARI LETTER YYA
+	0x09DC:  0x09A1,  // BENGALI LETTER RRA
+	0x09DD:  0x09A2,  // BENGALI LETTER RHA
+	0x09DF:  0x09AF,  // BENGALI LETTER YYA
+	0x0A33:  0x0A32,  // GURMUKHI LETTER LLA
+	0x0A36:  0x0A38,  // GURMUKHI LETTER SHA
+	0x0A59:  0x0A16,  // GURMUKHI LETTER KHHA
+	0x0A5A:  0x0A17,  // GURMUKHI LETTER GHHA
+	0x0A5B:  0x0A1C,  // GURMUKHI LETTER ZA
+	0x0A5E:  0x0A2B,  // GURMUKHI LETTER FA
+	0x0B48:  0x0B47,  // ORIYA VOWEL SIGN AI
+	0x0B5C:  0x0B21,  // ORIYA LETTER RRA
+	0x0B5D:  0x0B22,  // ORIYA LETTER RHA
+	0x0CC0:  0x0CD5,  // KANNADA VOWEL SIGN II
+	0x0CC7:  0x0CD5,  // KANNADA VOWEL SIGN EE
+	0x0CC8:  0x0CD6,  // KANNADA VOWEL SIGN AI
+	0x0CCA:  0x0CC2,  // KANNADA VOWEL SIGN O
+	0x0DDA:  0x0DD9,  // SINHALA VOWEL SIGN DIGA KOMBUVA
+	0x0F43:  0x0F42,  // TIBETAN LETTER GHA
+	0x0F4D:  0x0F4C,  // TIBETAN LETTER DDHA
+	0x0F52:  0x0F51,  // TIBETAN LETTER DHA
+	0x0F57:  0x0F56,  // TIBETAN LETTER BHA
+	0x0F5C:  0x0F5B,  // TIBETAN LETTER DZHA
+	0x0F69:  0x0F40,  // TIBETAN LETTER KSSA
+	0x1026:  0x1025,  // MYANMAR LETTER UU
+	0x1B3B:  0x1B35,  // BALINESE VOWEL SIGN RA REPA TEDUNG
+	0x1B3D:  0x1B35,  // BALINESE VOWEL SIGN LA LENGA TEDUNG
+	0x1B43:  0x1B35,  // BALINESE VOWEL SIGN PEPET TEDUNG
+	0x1E00:  0x0041,  // LATIN CAPITAL LETTER A WITH RING BELOW
+	0x1E01:  0x0061,  // LATIN SMALL LETTER A WITH RING BELOW
+	0x1E02:  0x0042,  // LATIN CAPITAL LETTER B WITH DOT ABOVE
+	0x1E03:  0x0062,  // LATIN SMALL LETTER B WITH DOT ABOVE
+	0x1E04:  0x0042,  // LATIN CAPITAL LETTER B WITH DOT BELOW
+	0x1E05:  0x0062,  // LATIN SMALL LETTER B WITH DOT BELOW
+	0x1E06:  0x0042,  // LATIN CAPITAL LETTER B WITH LINE BELOW
+	0x1E07:  0x0062,  // LATIN SMALL LETTER B WITH LINE BELOW
+	0x1E08:  0x0043,  // LATIN CAPITAL LETTER C WITH CEDILLA AND ACUTE
+	0x1E09:  0x0063,  // LATIN SMALL LETTER C WITH CEDILLA AND ACUTE
+	0x1E0A:  0x0044,  // LATIN CAPITAL LETTER D WITH DOT ABOVE
+	0x1E0B:  0x0064,  // LATIN SMALL LETTER D WITH DOT ABOVE
+	0x1E0C:  0x0044,  // LATIN CAPITAL LETTER D WITH DOT BELOW
+	0x1E0D:  0x0064,  // LATIN SMALL LETTER D WITH DOT BELOW
+	0x1E0E:  0x0044,  // LATIN CAPITAL LETTER D WITH LINE BELOW
+	0x1E0F:  0x0064,  // LATIN SMALL LETTER D WITH LINE BELOW
+	0x1E10:  0x0044,  // LATIN CAPITAL LETTER D WITH CEDILLA
+	0x1E11:  0x0064,  // LATIN SMALL LETTER D WITH CEDILLA
+	0x1E12:  0x0044,  // LATIN CAPITAL LETTER D WITH CIRCUMFLEX BELOW
+	0x1E13:  0x0064,  // LATIN SMALL LETTER D WITH CIRCUMFLEX BELOW
+	0x1E14:  0x0045,  // LATIN CAPITAL LETTER E WITH MACRON AND GRAVE
+	0x1E15:  0x0065,  // LATIN SMALL LETTER E WITH MACRON AND GRAVE
+	0x1E16:  0x0045,  // LATIN CAPITAL LETTER E WITH MACRON AND ACUTE
+	0x1E17:  0x0065,  // LATIN SMALL LETTER E WITH MACRON AND ACUTE
+	0x1E18:  0x0045,  // LATIN CAPITAL LETTER E WITH CIRCUMFLEX BELOW
+	0x1E19:  0x0065,  // LATIN SMALL LETTER E WITH CIRCUMFLEX BELOW
+	0x1E1A:  0x0045,  // LATIN CAPITAL LETTER E WITH TILDE BELOW
+	0x1E1B:  0x0065,  // LATIN SMALL LETTER E WITH TILDE BELOW
+	0x1E1C:  0x0045,  // LATIN CAPITAL LETTER E WITH CEDILLA AND BREVE
+	0x1E1D:  0x0065,  // LATIN SMALL LETTER E WITH CEDILLA AND BREVE
+	0x1E1E:  0x0046,  // LATIN CAPITAL LETTER F WITH DOT ABOVE
+	0x1E1F:  0x0066,  // LATIN SMALL LETTER F WITH DOT ABOVE
+	0x1E20:  0x0047,  // LATIN CAPITAL LETTER G WITH MACRON
+	0x1E21:  0x0067,  // LATIN SMALL LETTER G WITH MACRON
+	0x1E22:  0x0048,  // LATIN CAPITAL LETTER H WITH DOT ABOVE
+	0x1E23:  0x0068,  // LATIN SMALL LETTER H WITH DOT ABOVE
+	0x1E24:  0x0048,  // LATIN CAPITAL LETTER H WITH DOT BELOW
+	0x1E25:  0x0068,  // LATIN SMALL LETTER H WITH DOT BELOW
+	0x1E26:  0x0048,  // LATIN CAPITAL LETTER H WITH DIAERESIS
+	0x1E27:  0x0068,  // LATIN SMALL LETTER H WITH DIAERESIS
+	0x1E28:  0x0048,  // LATIN CAPITAL LETTER H WITH CEDILLA
+	0x1E29:  0x0068,  // LATIN SMALL LETTER H WITH CEDILLA
+	0x1E2A:  0x0048,  // LATIN CAPITAL LETTER H WITH BREVE BELOW
+	0x1E2B:  0x0068,  // LATIN SMALL LETTER H WITH BREVE BELOW
+	0x1E2C:  0x0049,  // LATIN CAPITAL LETTER I WITH TILDE BELOW
+	0x1E2D:  0x0069,  // LATIN SMALL LETTER I WITH TILDE BELOW
+	0x1E2E:  0x0049,  // LATIN CAPITAL LETTER I WITH DIAERESIS AND ACUTE
+	0x1E2F:  0x0069,  // LATIN SMALL LETTER I WITH DIAERESIS AND ACUTE
+	0x1E30:  0x004B,  // LATIN CAPITAL LETTER K WITH ACUTE
+	0x1E31:  0x006B,  // LATIN SMALL LETTER K WITH ACUTE
+	0x1E32:  0x004B,  // LATIN CAPITAL LETTER K WITH DOT BELOW
+	0x1E33:  0x006B,  // LATIN SMALL LETTER K WITH DOT BELOW
+	0x1E34:  0x004B,  // LATIN CAPITAL LETTER K WITH LINE BELOW
+	0x1E35:  0x006B,  // LATIN SMALL LETTER K WITH LINE BELOW
+	0x1E36:  0x004C,  // LATIN CAPITAL LETTER L WITH DOT BELOW
+	0x1E37:  0x006C,  // LATIN SMALL LETTER L WITH DOT BELOW
+	0x1E38:  0x004C,  // LATIN CAPITAL LETTER L WITH DOT BELOW AND MACRON
+	0x1E39:  0x006C,  // LATIN SMALL LETTER L WITH DOT BELOW AND MACRON
+	0x1E3A:  0x004C,  // LATIN CAPITAL LETTER L WITH LINE BELOW
+	0x1E3B:  0x006C,  // LATIN SMALL LETTER L WITH LINE BELOW
+	0x1E3C:  0x004C,  // LATIN CAPITAL LETTER L WITH CIRCUMFLEX BELOW
+	0x1E3D:  0x006C,  // LATIN SMALL LETTER L WITH CIRCUMFLEX BELOW
+	0x1E3E:  0x004D,  // LATIN CAPITAL LETTER M WITH ACUTE
+	0x1E3F:  0x006D,  // LATIN SMALL LETTER M WITH ACUTE
+	0x1E40:  0x004D,  // LATIN CAPITAL LETTER M WITH DOT ABOVE
+	0x1E41:  0x006D,  // LATIN SMALL LETTER M WITH DOT ABOVE
+	0x1E42:  0x004D,  // LATIN CAPITAL LETTER M WITH DOT BELOW
+	0x1E43:  0x006D,  // LATIN SMALL LETTER M WITH DOT BELOW
+	0x1E44:  0x004E,  // LATIN CAPITAL LETTER N WITH DOT ABOVE
+	0x1E45:  0x006E,  // LATIN SMALL LETTER N WITH DOT ABOVE
+	0x1E46:  0x004E,  // LATIN CAPITAL LETTER N WITH DOT BELOW
+	0x1E47:  0x006E,  // LATIN SMALL LETTER N WITH DOT BELOW
+	0x1E48:  0x004E,  // LATIN CAPITAL LETTER N WITH LINE BELOW
+	0x1E49:  0x006E,  // LATIN SMALL LETTER N WITH LINE BELOW
+	0x1E4A:  0x004E,  // LATIN CAPITAL LETTER N WITH CIRCUMFLEX BELOW
+	0x1E4B:  0x006E,  // LATIN SMALL LETTER N WITH CIRCUMFLEX BELOW
+	0x1E4C:  0x004F,  // LATIN CAPITAL LETTER O WITH TILDE AND ACUTE
+	0x1E4D:  0x006F,  // LATIN SMALL LETTER O WITH TILDE AND ACUTE
+	0x1E4E:  0x004F,  // LATIN CAPITAL LETTER O WITH TILDE AND DIAERESIS
+	0x1E4F:  0x006F,  // LATIN SMALL LETTER O WITH TILDE AND DIAERESIS
+	0x1E50:  0x004F,  // LATIN CAPITAL LETTER O WITH MACRON AND GRAVE
+	0x1E51:  0x006F,  // LATIN SMALL LETTER O WITH MACRON AND GRAVE
+	0x1E52:  0x004F,  // LATIN CAPITAL LETTER O WITH MACRON AND ACUTE
+	0x1E53:  0x006F,  // LATIN SMALL LETTER O WITH MACRON AND ACUTE
+	0x1E54:  0x0050,  // LATIN CAPITAL LETTER P WITH ACUTE
+	0x1E55:  0x0070,  // LATIN SMALL LETTER P WITH ACUTE
+	0x1E56:  0x0050,  // LATIN CAPITAL LETTER P WITH DOT ABOVE
+	0x1E57:  0x0070,  // LATIN SMALL LETTER P WITH DOT ABOVE
+	0x1E58:  0x0052,  // LATIN CAPITAL LETTER R WITH DOT ABOVE
+	0x1E59:  0x0072,  // LATIN SMALL LETTER R WITH DOT ABOVE
+	0x1E5A:  0x0052,  // LATIN CAPITAL LETTER R WITH DOT BELOW
+	0x1E5B:  0x0072,  // LATIN SMALL LETTER R WITH DOT BELOW
+	0x1E5C:  0x0052,  // LATIN CAPITAL LETTER R WITH DOT BELOW AND MACRON
+	0x1E5D:  0x0072,  // LATIN SMALL LETTER R WITH DOT BELOW AND MACRON
+	0x1E5E:  0x0052,  // LATIN CAPITAL LETTER R WITH LINE BELOW
+	0x1E5F:  0x0072,  // LATIN SMALL LETTER R WITH LINE BELOW
+	0x1E60:  0x0053,  // LATIN CAPITAL LETTER S WITH DOT ABOVE
+	0x1E61:  0x0073,  // LATIN SMALL LETTER S WITH DOT ABOVE
+	0x1E62:  0x0053,  // LATIN CAPITAL LETTER S WITH DOT BELOW
+	0x1E63:  0x0073,  // LATIN SMALL LETTER S WITH DOT BELOW
+	0x1E64:  0x0053,  // LATIN CAPITAL LETTER S WITH ACUTE AND DOT ABOVE
+	0x1E65:  0x0073,  // LATIN SMALL LETTER S WITH ACUTE AND DOT ABOVE
+	0x1E66:  0x0053,  // LATIN CAPITAL LETTER S WITH CARON AND DOT ABOVE
+	0x1E67:  0x0073,  // LATIN SMALL LETTER S WITH CARON AND DOT ABOVE
+	0x1E68:  0x0053,  // LATIN CAPITAL LETTER S WITH DOT BELOW AND DOT ABOVE
+	0x1E69:  0x0073,  // LATIN SMALL LETTER S WITH DOT BELOW AND DOT ABOVE
+	0x1E6A:  0x0054,  // LATIN CAPITAL LETTER T WITH DOT ABOVE
+	0x1E6B:  0x0074,  // LATIN SMALL LETTER T WITH DOT ABOVE
+	0x1E6C:  0x0054,  // LATIN CAPITAL LETTER T WITH DOT BELOW
+	0x1E6D:  0x0074,  // LATIN SMALL LETTER T WITH DOT BELOW
+	0x1E6E:  0x0054,  // LATIN CAPITAL LETTER T WITH LINE BELOW
+	0x1E6F:  0x0074,  // LATIN SMALL LETTER T WITH LINE BELOW
+	0x1E70:  0x0054,  // LATIN CAPITAL LETTER T WITH CIRCUMFLEX BELOW
+	0x1E71:  0x0074,  // LATIN SMALL LETTER T WITH CIRCUMFLEX BELOW
+	0x1E72:  0x0055,  // LATIN CAPITAL LETTER U WITH DIAERESIS BELOW
+	0x1E73:  0x0075,  // LATIN SMALL LETTER U WITH DIAERESIS BELOW
+	0x1E74:  0x0055,  // LATIN CAPITAL LETTER U WITH TILDE BELOW
+	0x1E75:  0x0075,  // LATIN SMALL LETTER U WITH TILDE BELOW
+	0x1E76:  0x0055,  // LATIN CAPITAL LETTER U WITH CIRCUMFLEX BELOW
+	0x1E77:  0x0075,  // LATIN SMALL LETTER U WITH CIRCUMFLEX BELOW
+	0x1E78:  0x0055,  // LATIN CAPITAL LETTER U WITH TILDE AND ACUTE
+	0x1E79:  0x0075,  // LATIN SMALL LETTER U WITH TILDE AND ACUTE
+	0x1E7A:  0x0055,  // LATIN CAPITAL LETTER U WITH MACRON AND DIAERESIS
+	0x1E7B:  0x0075,  // LATIN SMALL LETTER U WITH MACRON AND DIAERESIS
+	0x1E7C:  0x0056,  // LATIN CAPITAL LETTER V WITH TILDE
+	0x1E7D:  0x0076,  // LATIN SMALL LETTER V WITH TILDE
+	0x1E7E:  0x0056,  // LATIN CAPITAL LETTER V WITH DOT BELOW
+	0x1E7F:  0x0076,  // LATIN SMALL LETTER V WITH DOT BELOW
+	0x1E80:  0x0057,  // LATIN CAPITAL LETTER W WITH GRAVE
+	0x1E81:  0x0077,  // LATIN SMALL LETTER W WITH GRAVE
+	0x1E82:  0x0057,  // LATIN CAPITAL LETTER W WITH ACUTE
+	0x1E83:  0x0077,  // LATIN SMALL LETTER W WITH ACUTE
+	0x1E84:  0x0057,  // LATIN CAPITAL LETTER W WITH DIAERESIS
+	0x1E85:  0x0077,  // LATIN SMALL LETTER W WITH DIAERESIS
+	0x1E86:  0x0057,  // LATIN CAPITAL LETTER W WITH DOT ABOVE
+	0x1E87:  0x0077,  // LATIN SMALL LETTER W WITH DOT ABOVE
+	0x1E88:  0x0057,  // LATIN CAPITAL LETTER W WITH DOT BELOW
+	0x1E89:  0x0077,  // LATIN SMALL LETTER W WITH DOT BELOW
+	0x1E8A:  0x0058,  // LATIN CAPITAL LETTER X WITH DOT ABOVE
+	0x1E8B:  0x0078,  // LATIN SMALL LETTER X WITH DOT ABOVE
+	0x1E8C:  0x0058,  // LATIN CAPITAL LETTER X WITH DIAERESIS
+	0x1E8D:  0x0078,  // LATIN SMALL LETTER X WITH DIAERESIS
+	0x1E8E:  0x0059,  // LATIN CAPITAL LETTER Y WITH DOT ABOVE
+	0x1E8F:  0x0079,  // LATIN SMALL LETTER Y WITH DOT ABOVE
+	0x1E90:  0x005A,  // LATIN CAPITAL LETTER Z WITH CIRCUMFLEX
+	0x1E91:  0x007A,  // LATIN SMALL LETTER Z WITH CIRCUMFLEX
+	0x1E92:  0x005A,  // LATIN CAPITAL LETTER Z WITH DOT BELOW
+	0x1E93:  0x007A,  // LATIN SMALL LETTER Z WITH DOT BELOW
+	0x1E94:  0x005A,  // LATIN CAPITAL LETTER Z WITH LINE BELOW
+	0x1E95:  0x007A,  // LATIN SMALL LETTER Z WITH LINE BELOW
+	0x1E96:  0x0068,  // LATIN SMALL LETTER H WITH LINE BELOW
+	0x1E97:  0x0074,  // LATIN SMALL LETTER T WITH DIAERESIS
+	0x1E98:  0x0077,  // LATIN SMALL LETTER W WITH RING ABOVE
+	0x1E99:  0x0079,  // LATIN SMALL LETTER Y WITH RING ABOVE
+	0x1E9B:  0x017F,  // LATIN SMALL LETTER LONG S WITH DOT ABOVE
+	0x1EA0:  0x0041,  // LATIN CAPITAL LETTER A WITH DOT BELOW
+	0x1EA1:  0x0061,  // LATIN SMALL LETTER A WITH DOT BELOW
+	0x1EA2:  0x0041,  // LATIN CAPITAL LETTER A WITH HOOK ABOVE
+	0x1EA3:  0x0061,  // LATIN SMALL LETTER A WITH HOOK ABOVE
+	0x1EA4:  0x0041,  // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND ACUTE
+	0x1EA5:  0x0061,  // LATIN SMALL LETTER A WITH CIRCUMFLEX AND ACUTE
+	0x1EA6:  0x0041,  // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND GRAVE
+	0x1EA7:  0x0061,  // LATIN SMALL LETTER A WITH CIRCUMFLEX AND GRAVE
+	0x1EA8:  0x0041,  // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EA9:  0x0061,  // LATIN SMALL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EAA:  0x0041,  // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND TILDE
+	0x1EAB:  0x0061,  // LATIN SMALL LETTER A WITH CIRCUMFLEX AND TILDE
+	0x1EAC:  0x0041,  // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+	0x1EAD:  0x0061,  // LATIN SMALL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+	0x1EAE:  0x0041,  // LATIN CAPITAL LETTER A WITH BREVE AND ACUTE
+	0x1EAF:  0x0061,  // LATIN SMALL LETTER A WITH BREVE AND ACUTE
+	0x1EB0:  0x0041,  // LATIN CAPITAL LETTER A WITH BREVE AND GRAVE
+	0x1EB1:  0x0061,  // LATIN SMALL LETTER A WITH BREVE AND GRAVE
+	0x1EB2:  0x0041,  // LATIN CAPITAL LETTER A WITH BREVE AND HOOK ABOVE
+	0x1EB3:  0x0061,  // LATIN SMALL LETTER A WITH BREVE AND HOOK ABOVE
+	0x1EB4:  0x0041,  // LATIN CAPITAL LETTER A WITH BREVE AND TILDE
+	0x1EB5:  0x0061,  // LATIN SMALL LETTER A WITH BREVE AND TILDE
+	0x1EB6:  0x0041,  // LATIN CAPITAL LETTER A WITH BREVE AND DOT BELOW
+	0x1EB7:  0x0061,  // LATIN SMALL LETTER A WITH BREVE AND DOT BELOW
+	0x1EB8:  0x0045,  // LATIN CAPITAL LETTER E WITH DOT BELOW
+	0x1EB9:  0x0065,  // LATIN SMALL LETTER E WITH DOT BELOW
+	0x1EBA:  0x0045,  // LATIN CAPITAL LETTER E WITH HOOK ABOVE
+	0x1EBB:  0x0065,  // LATIN SMALL LETTER E WITH HOOK ABOVE
+	0x1EBC:  0x0045,  // LATIN CAPITAL LETTER E WITH TILDE
+	0x1EBD:  0x0065,  // LATIN SMALL LETTER E WITH TILDE
+	0x1EBE:  0x0045,  // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND ACUTE
+	0x1EBF:  0x0065,  // LATIN SMALL LETTER E WITH CIRCUMFLEX AND ACUTE
+	0x1EC0:  0x0045,  // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND GRAVE
+	0x1EC1:  0x0065,  // LATIN SMALL LETTER E WITH CIRCUMFLEX AND GRAVE
+	0x1EC2:  0x0045,  // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EC3:  0x0065,  // LATIN SMALL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EC4:  0x0045,  // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND TILDE
+	0x1EC5:  0x0065,  // LATIN SMALL LETTER E WITH CIRCUMFLEX AND TILDE
+	0x1EC6:  0x0045,  // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+	0x1EC7:  0x0065,  // LATIN SMALL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+	0x1EC8:  0x0049,  // LATIN CAPITAL LETTER I WITH HOOK ABOVE
+	0x1EC9:  0x0069,  // LATIN SMALL LETTER I WITH HOOK ABOVE
+	0x1ECA:  0x0049,  // LATIN CAPITAL LETTER I WITH DOT BELOW
+	0x1ECB:  0x0069,  // LATIN SMALL LETTER I WITH DOT BELOW
+	0x1ECC:  0x004F,  // LATIN CAPITAL LETTER O WITH DOT BELOW
+	0x1ECD:  0x006F,  // LATIN SMALL LETTER O WITH DOT BELOW
+	0x1ECE:  0x004F,  // LATIN CAPITAL LETTER O WITH HOOK ABOVE
+	0x1ECF:  0x006F,  // LATIN SMALL LETTER O WITH HOOK ABOVE
+	0x1ED0:  0x004F,  // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND ACUTE
+	0x1ED1:  0x006F,  // LATIN SMALL LETTER O WITH CIRCUMFLEX AND ACUTE
+	0x1ED2:  0x004F,  // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND GRAVE
+	0x1ED3:  0x006F,  // LATIN SMALL LETTER O WITH CIRCUMFLEX AND GRAVE
+	0x1ED4:  0x004F,  // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1ED5:  0x006F,  // LATIN SMALL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1ED6:  0x004F,  // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND TILDE
+	0x1ED7:  0x006F,  // LATIN SMALL LETTER O WITH CIRCUMFLEX AND TILDE
+	0x1ED8:  0x004F,  // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+	0x1ED9:  0x006F,  // LATIN SMALL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+	0x1EDA:  0x004F,  // LATIN CAPITAL LETTER O WITH HORN AND ACUTE
+	0x1EDB:  0x006F,  // LATIN SMALL LETTER O WITH HORN AND ACUTE
+	0x1EDC:  0x004F,  // LATIN CAPITAL LETTER O WITH HORN AND GRAVE
+	0x1EDD:  0x006F,  // LATIN SMALL LETTER O WITH HORN AND GRAVE
+	0x1EDE:  0x004F,  // LATIN CAPITAL LETTER O WITH HORN AND HOOK ABOVE
+	0x1EDF:  0x006F,  // LATIN SMALL LETTER O WITH HORN AND HOOK ABOVE
+	0x1EE0:  0x004F,  // LATIN CAPITAL LETTER O WITH HORN AND TILDE
+	0x1EE1:  0x006F,  // LATIN SMALL LETTER O WITH HORN AND TILDE
+	0x1EE2:  0x004F,  // LATIN CAPITAL LETTER O WITH HORN AND DOT BELOW
+	0x1EE3:  0x006F,  // LATIN SMALL LETTER O WITH HORN AND DOT BELOW
+	0x1EE4:  0x0055,  // LATIN CAPITAL LETTER U WITH DOT BELOW
+	0x1EE5:  0x0075,  // LATIN SMALL LETTER U WITH DOT BELOW
+	0x1EE6:  0x0055,  // LATIN CAPITAL LETTER U WITH HOOK ABOVE
+	0x1EE7:  0x0075,  // LATIN SMALL LETTER U WITH HOOK ABOVE
+	0x1EE8:  0x0055,  // LATIN CAPITAL LETTER U WITH HORN AND ACUTE
+	0x1EE9:  0x0075,  // LATIN SMALL LETTER U WITH HORN AND ACUTE
+	0x1EEA:  0x0055,  // LATIN CAPITAL LETTER U WITH HORN AND GRAVE
+	0x1EEB:  0x0075,  // LATIN SMALL LETTER U WITH HORN AND GRAVE
+	0x1EEC:  0x0055,  // LATIN CAPITAL LETTER U WITH HORN AND HOOK ABOVE
+	0x1EED:  0x0075,  // LATIN SMALL LETTER U WITH HORN AND HOOK ABOVE
+	0x1EEE:  0x0055,  // LATIN CAPITAL LETTER U WITH HORN AND TILDE
+	0x1EEF:  0x0075,  // LATIN SMALL LETTER U WITH HORN AND TILDE
+	0x1EF0:  0x0055,  // LATIN CAPITAL LETTER U WITH HORN AND DOT BELOW
+	0x1EF1:  0x0075,  // LATIN SMALL LETTER U WITH HORN AND DOT BELOW
+	0x1EF2:  0x0059,  // LATIN CAPITAL LETTER Y WITH GRAVE
+	0x1EF3:  0x0079,  // LATIN SMALL LETTER Y WITH GRAVE
+	0x1EF4:  0x0059,  // LATIN CAPITAL LETTER Y WITH DOT BELOW
+	0x1EF5:  0x0079,  // LATIN SMALL LETTER Y WITH DOT BELOW
+	0x1EF6:  0x0059,  // LATIN CAPITAL LETTER Y WITH HOOK ABOVE
+	0x1EF7:  0x0079,  // LATIN SMALL LETTER Y WITH HOOK ABOVE
+	0x1EF8:  0x0059,  // LATIN CAPITAL LETTER Y WITH TILDE
+	0x1EF9:  0x0079,  // LATIN SMALL LETTER Y WITH TILDE
+	0x1F00:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PSILI
+	0x1F01:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH DASIA
+	0x1F02:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA
+	0x1F03:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA
+	0x1F04:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA
+	0x1F05:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA
+	0x1F06:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI
+	0x1F07:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI
+	0x1F08:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PSILI
+	0x1F09:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH DASIA
+	0x1F0A:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA
+	0x1F0B:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA
+	0x1F0C:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA
+	0x1F0D:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA
+	0x1F0E:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI
+	0x1F0F:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI
+	0x1F10:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH PSILI
+	0x1F11:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH DASIA
+	0x1F12:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH PSILI AND VARIA
+	0x1F13:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH DASIA AND VARIA
+	0x1F14:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH PSILI AND OXIA
+	0x1F15:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH DASIA AND OXIA
+	0x1F18:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH PSILI
+	0x1F19:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH DASIA
+	0x1F1A:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH PSILI AND VARIA
+	0x1F1B:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH DASIA AND VARIA
+	0x1F1C:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH PSILI AND OXIA
+	0x1F1D:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH DASIA AND OXIA
+	0x1F20:  0x03B7,  // GREEK SMALL LETTER ETA WITH PSILI
+	0x1F21:  0x03B7,  // GREEK SMALL LETTER ETA WITH DASIA
+	0x1F22:  0x03B7,  // GREEK SMALL LETTER ETA WITH PSILI AND VARIA
+	0x1F23:  0x03B7,  // GREEK SMALL LETTER ETA WITH DASIA AND VARIA
+	0x1F24:  0x03B7,  // GREEK SMALL LETTER ETA WITH PSILI AND OXIA
+	0x1F25:  0x03B7,  // GREEK SMALL LETTER ETA WITH DASIA AND OXIA
+	0x1F26:  0x03B7,  // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI
+	0x1F27:  0x03B7,  // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI
+	0x1F28:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PSILI
+	0x1F29:  0x0397,  // GREEK CAPITAL LETTER ETA WITH DASIA
+	0x1F2A:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA
+	0x1F2B:  0x0397,  // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA
+	0x1F2C:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA
+	0x1F2D:  0x0397,  // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA
+	0x1F2E:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI
+	0x1F2F:  0x0397,  // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI
+	0x1F30:  0x03B9,  // GREEK SMALL LETTER IOTA WITH PSILI
+	0x1F31:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DASIA
+	0x1F32:  0x03B9,  // GREEK SMALL LETTER IOTA WITH PSILI AND VARIA
+	0x1F33:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DASIA AND VARIA
+	0x1F34:  0x03B9,  // GREEK SMALL LETTER IOTA WITH PSILI AND OXIA
+	0x1F35:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DASIA AND OXIA
+	0x1F36:  0x03B9,  // GREEK SMALL LETTER IOTA WITH PSILI AND PERISPOMENI
+	0x1F37:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DASIA AND PERISPOMENI
+	0x1F38:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH PSILI
+	0x1F39:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH DASIA
+	0x1F3A:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH PSILI AND VARIA
+	0x1F3B:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH DASIA AND VARIA
+	0x1F3C:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH PSILI AND OXIA
+	0x1F3D:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH DASIA AND OXIA
+	0x1F3E:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH PSILI AND PERISPOMENI
+	0x1F3F:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH DASIA AND PERISPOMENI
+	0x1F40:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH PSILI
+	0x1F41:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH DASIA
+	0x1F42:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH PSILI AND VARIA
+	0x1F43:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH DASIA AND VARIA
+	0x1F44:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH PSILI AND OXIA
+	0x1F45:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH DASIA AND OXIA
+	0x1F48:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH PSILI
+	0x1F49:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH DASIA
+	0x1F4A:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH PSILI AND VARIA
+	0x1F4B:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH DASIA AND VARIA
+	0x1F4C:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH PSILI AND OXIA
+	0x1F4D:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH DASIA AND OXIA
+	0x1F50:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH PSILI
+	0x1F51:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DASIA
+	0x1F52:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH PSILI AND VARIA
+	0x1F53:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DASIA AND VARIA
+	0x1F54:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH PSILI AND OXIA
+	0x1F55:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DASIA AND OXIA
+	0x1F56:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH PSILI AND PERISPOMENI
+	0x1F57:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DASIA AND PERISPOMENI
+	0x1F59:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH DASIA
+	0x1F5B:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH DASIA AND VARIA
+	0x1F5D:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH DASIA AND OXIA
+	0x1F5F:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH DASIA AND PERISPOMENI
+	0x1F60:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PSILI
+	0x1F61:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH DASIA
+	0x1F62:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA
+	0x1F63:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA
+	0x1F64:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA
+	0x1F65:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA
+	0x1F66:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI
+	0x1F67:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI
+	0x1F68:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PSILI
+	0x1F69:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH DASIA
+	0x1F6A:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA
+	0x1F6B:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA
+	0x1F6C:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA
+	0x1F6D:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA
+	0x1F6E:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI
+	0x1F6F:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI
+	0x1F70:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH VARIA
+	0x1F71:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH OXIA
+	0x1F72:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH VARIA
+	0x1F73:  0x03B5,  // GREEK SMALL LETTER EPSILON WITH OXIA
+	0x1F74:  0x03B7,  // GREEK SMALL LETTER ETA WITH VARIA
+	0x1F75:  0x03B7,  // GREEK SMALL LETTER ETA WITH OXIA
+	0x1F76:  0x03B9,  // GREEK SMALL LETTER IOTA WITH VARIA
+	0x1F77:  0x03B9,  // GREEK SMALL LETTER IOTA WITH OXIA
+	0x1F78:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH VARIA
+	0x1F79:  0x03BF,  // GREEK SMALL LETTER OMICRON WITH OXIA
+	0x1F7A:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH VARIA
+	0x1F7B:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH OXIA
+	0x1F7C:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH VARIA
+	0x1F7D:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH OXIA
+	0x1F80:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PSILI AND YPOGEGRAMMENI
+	0x1F81:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH DASIA AND YPOGEGRAMMENI
+	0x1F82:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F83:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F84:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F85:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F86:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F87:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F88:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PROSGEGRAMMENI
+	0x1F89:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PROSGEGRAMMENI
+	0x1F8A:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F8B:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F8C:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F8D:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F8E:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F8F:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F90:  0x03B7,  // GREEK SMALL LETTER ETA WITH PSILI AND YPOGEGRAMMENI
+	0x1F91:  0x03B7,  // GREEK SMALL LETTER ETA WITH DASIA AND YPOGEGRAMMENI
+	0x1F92:  0x03B7,  // GREEK SMALL LETTER ETA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F93:  0x03B7,  // GREEK SMALL LETTER ETA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F94:  0x03B7,  // GREEK SMALL LETTER ETA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F95:  0x03B7,  // GREEK SMALL LETTER ETA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F96:  0x03B7,  // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F97:  0x03B7,  // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F98:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PSILI AND PROSGEGRAMMENI
+	0x1F99:  0x0397,  // GREEK CAPITAL LETTER ETA WITH DASIA AND PROSGEGRAMMENI
+	0x1F9A:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F9B:  0x0397,  // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F9C:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F9D:  0x0397,  // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F9E:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F9F:  0x0397,  // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FA0:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PSILI AND YPOGEGRAMMENI
+	0x1FA1:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH DASIA AND YPOGEGRAMMENI
+	0x1FA2:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1FA3:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1FA4:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1FA5:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1FA6:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA7:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA8:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PROSGEGRAMMENI
+	0x1FA9:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PROSGEGRAMMENI
+	0x1FAA:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1FAB:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1FAC:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1FAD:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1FAE:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FAF:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FB0:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH VRACHY
+	0x1FB1:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH MACRON
+	0x1FB2:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH VARIA AND YPOGEGRAMMENI
+	0x1FB3:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH YPOGEGRAMMENI
+	0x1FB4:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH OXIA AND YPOGEGRAMMENI
+	0x1FB6:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PERISPOMENI
+	0x1FB7:  0x03B1,  // GREEK SMALL LETTER ALPHA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FB8:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH VRACHY
+	0x1FB9:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH MACRON
+	0x1FBA:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH VARIA
+	0x1FBB:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH OXIA
+	0x1FBC:  0x0391,  // GREEK CAPITAL LETTER ALPHA WITH PROSGEGRAMMENI
+	0x1FBE:  0x03B9,  // GREEK PROSGEGRAMMENI
+	0x1FC1:  0x00A8,  // GREEK DIALYTIKA AND PERISPOMENI
+	0x1FC2:  0x03B7,  // GREEK SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI
+	0x1FC3:  0x03B7,  // GREEK SMALL LETTER ETA WITH YPOGEGRAMMENI
+	0x1FC4:  0x03B7,  // GREEK SMALL LETTER ETA WITH OXIA AND YPOGEGRAMMENI
+	0x1FC6:  0x03B7,  // GREEK SMALL LETTER ETA WITH PERISPOMENI
+	0x1FC7:  0x03B7,  // GREEK SMALL LETTER ETA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FC8:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH VARIA
+	0x1FC9:  0x0395,  // GREEK CAPITAL LETTER EPSILON WITH OXIA
+	0x1FCA:  0x0397,  // GREEK CAPITAL LETTER ETA WITH VARIA
+	0x1FCB:  0x0397,  // GREEK CAPITAL LETTER ETA WITH OXIA
+	0x1FCC:  0x0397,  // GREEK CAPITAL LETTER ETA WITH PROSGEGRAMMENI
+	0x1FCD:  0x1FBF,  // GREEK PSILI AND VARIA
+	0x1FCE:  0x1FBF,  // GREEK PSILI AND OXIA
+	0x1FCF:  0x1FBF,  // GREEK PSILI AND PERISPOMENI
+	0x1FD0:  0x03B9,  // GREEK SMALL LETTER IOTA WITH VRACHY
+	0x1FD1:  0x03B9,  // GREEK SMALL LETTER IOTA WITH MACRON
+	0x1FD2:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND VARIA
+	0x1FD3:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND OXIA
+	0x1FD6:  0x03B9,  // GREEK SMALL LETTER IOTA WITH PERISPOMENI
+	0x1FD7:  0x03B9,  // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND PERISPOMENI
+	0x1FD8:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH VRACHY
+	0x1FD9:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH MACRON
+	0x1FDA:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH VARIA
+	0x1FDB:  0x0399,  // GREEK CAPITAL LETTER IOTA WITH OXIA
+	0x1FDD:  0x1FFE,  // GREEK DASIA AND VARIA
+	0x1FDE:  0x1FFE,  // GREEK DASIA AND OXIA
+	0x1FDF:  0x1FFE,  // GREEK DASIA AND PERISPOMENI
+	0x1FE0:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH VRACHY
+	0x1FE1:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH MACRON
+	0x1FE2:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND VARIA
+	0x1FE3:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND OXIA
+	0x1FE4:  0x03C1,  // GREEK SMALL LETTER RHO WITH PSILI
+	0x1FE5:  0x03C1,  // GREEK SMALL LETTER RHO WITH DASIA
+	0x1FE6:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH PERISPOMENI
+	0x1FE7:  0x03C5,  // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND PERISPOMENI
+	0x1FE8:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH VRACHY
+	0x1FE9:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH MACRON
+	0x1FEA:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH VARIA
+	0x1FEB:  0x03A5,  // GREEK CAPITAL LETTER UPSILON WITH OXIA
+	0x1FEC:  0x03A1,  // GREEK CAPITAL LETTER RHO WITH DASIA
+	0x1FED:  0x00A8,  // GREEK DIALYTIKA AND VARIA
+	0x1FEE:  0x00A8,  // GREEK DIALYTIKA AND OXIA
+	0x1FEF:  0x0060,  // GREEK VARIA
+	0x1FF2:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI
+	0x1FF3:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH YPOGEGRAMMENI
+	0x1FF4:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	0x1FF6:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PERISPOMENI
+	0x1FF7:  0x03C9,  // GREEK SMALL LETTER OMEGA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FF8:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH VARIA
+	0x1FF9:  0x039F,  // GREEK CAPITAL LETTER OMICRON WITH OXIA
+	0x1FFA:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH VARIA
+	0x1FFB:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH OXIA
+	0x1FFC:  0x03A9,  // GREEK CAPITAL LETTER OMEGA WITH PROSGEGRAMMENI
+	0x1FFD:  0x00B4,  // GREEK OXIA
+	0x2000:  0x2002,  // EN QUAD
+	0x2001:  0x2003,  // EM QUAD
+	0x2126:  0x03A9,  // OHM SIGN
+	0x212A:  0x004B,  // KELVIN SIGN
+	0x212B:  0x0041,  // ANGSTROM SIGN
+	0x219A:  0x2190,  // LEFTWARDS ARROW WITH STROKE
+	0x219B:  0x2192,  // RIGHTWARDS ARROW WITH STROKE
+	0x21AE:  0x2194,  // LEFT RIGHT ARROW WITH STROKE
+	0x21CD:  0x21D0,  // LEFTWARDS DOUBLE ARROW WITH STROKE
+	0x21CE:  0x21D4,  // LEFT RIGHT DOUBLE ARROW WITH STROKE
+	0x21CF:  0x21D2,  // RIGHTWARDS DOUBLE ARROW WITH STROKE
+	0x2204:  0x2203,  // THERE DOES NOT EXIST
+	0x2209:  0x2208,  // NOT AN ELEMENT OF
+	0x220C:  0x220B,  // DOES NOT CONTAIN AS MEMBER
+	0x2224:  0x2223,  // DOES NOT DIVIDE
+	0x2226:  0x2225,  // NOT PARALLEL TO
+	0x2241:  0x223C,  // NOT TILDE
+	0x2244:  0x2243,  // NOT ASYMPTOTICALLY EQUAL TO
+	0x2247:  0x2245,  // NEITHER APPROXIMATELY NOR ACTUALLY EQUAL TO
+	0x2249:  0x2248,  // NOT ALMOST EQUAL TO
+	0x2260:  0x003D,  // NOT EQUAL TO
+	0x2262:  0x2261,  // NOT IDENTICAL TO
+	0x226D:  0x224D,  // NOT EQUIVALENT TO
+	0x226E:  0x003C,  // NOT LESS-THAN
+	0x226F:  0x003E,  // NOT GREATER-THAN
+	0x2270:  0x2264,  // NEITHER LESS-THAN NOR EQUAL TO
+	0x2271:  0x2265,  // NEITHER GREATER-THAN NOR EQUAL TO
+	0x2274:  0x2272,  // NEITHER LESS-THAN NOR EQUIVALENT TO
+	0x2275:  0x2273,  // NEITHER GREATER-THAN NOR EQUIVALENT TO
+	0x2278:  0x2276,  // NEITHER LESS-THAN NOR GREATER-THAN
+	0x2279:  0x2277,  // NEITHER GREATER-THAN NOR LESS-THAN
+	0x2280:  0x227A,  // DOES NOT PRECEDE
+	0x2281:  0x227B,  // DOES NOT SUCCEED
+	0x2284:  0x2282,  // NOT A SUBSET OF
+	0x2285:  0x2283,  // NOT A SUPERSET OF
+	0x2288:  0x2286,  // NEITHER A SUBSET OF NOR EQUAL TO
+	0x2289:  0x2287,  // NEITHER A SUPERSET OF NOR EQUAL TO
+	0x22AC:  0x22A2,  // DOES NOT PROVE
+	0x22AD:  0x22A8,  // NOT TRUE
+	0x22AE:  0x22A9,  // DOES NOT FORCE
+	0x22AF:  0x22AB,  // NEGATED DOUBLE VERTICAL BAR DOUBLE RIGHT TURNSTILE
+	0x22E0:  0x227C,  // DOES NOT PRECEDE OR EQUAL
+	0x22E1:  0x227D,  // DOES NOT SUCCEED OR EQUAL
+	0x22E2:  0x2291,  // NOT SQUARE IMAGE OF OR EQUAL TO
+	0x22E3:  0x2292,  // NOT SQUARE ORIGINAL OF OR EQUAL TO
+	0x22EA:  0x22B2,  // NOT NORMAL SUBGROUP OF
+	0x22EB:  0x22B3,  // DOES NOT CONTAIN AS NORMAL SUBGROUP
+	0x22EC:  0x22B4,  // NOT NORMAL SUBGROUP OF OR EQUAL TO
+	0x22ED:  0x22B5,  // DOES NOT CONTAIN AS NORMAL SUBGROUP OR EQUAL
+	0x2329:  0x3008,  // LEFT-POINTING ANGLE BRACKET
+	0x232A:  0x3009,  // RIGHT-POINTING ANGLE BRACKET
+	0x2ADC:  0x2ADD,  // FORKING
+	0x304C:  0x304B,  // HIRAGANA LETTER GA
+	0x304E:  0x304D,  // HIRAGANA LETTER GI
+	0x3050:  0x304F,  // HIRAGANA LETTER GU
+	0x3052:  0x3051,  // HIRAGANA LETTER GE
+	0x3054:  0x3053,  // HIRAGANA LETTER GO
+	0x3056:  0x3055,  // HIRAGANA LETTER ZA
+	0x3058:  0x3057,  // HIRAGANA LETTER ZI
+	0x305A:  0x3059,  // HIRAGANA LETTER ZU
+	0x305C:  0x305B,  // HIRAGANA LETTER ZE
+	0x305E:  0x305D,  // HIRAGANA LETTER ZO
+	0x3060:  0x305F,  // HIRAGANA LETTER DA
+	0x3062:  0x3061,  // HIRAGANA LETTER DI
+	0x3065:  0x3064,  // HIRAGANA LETTER DU
+	0x3067:  0x3066,  // HIRAGANA LETTER DE
+	0x3069:  0x3068,  // HIRAGANA LETTER DO
+	0x3070:  0x306F,  // HIRAGANA LETTER BA
+	0x3071:  0x306F,  // HIRAGANA LETTER PA
+	0x3073:  0x3072,  // HIRAGANA LETTER BI
+	0x3074:  0x3072,  // HIRAGANA LETTER PI
+	0x3076:  0x3075,  // HIRAGANA LETTER BU
+	0x3077:  0x3075,  // HIRAGANA LETTER PU
+	0x3079:  0x3078,  // HIRAGANA LETTER BE
+	0x307A:  0x3078,  // HIRAGANA LETTER PE
+	0x307C:  0x307B,  // HIRAGANA LETTER BO
+	0x307D:  0x307B,  // HIRAGANA LETTER PO
+	0x3094:  0x3046,  // HIRAGANA LETTER VU
+	0x309E:  0x309D,  // HIRAGANA VOICED ITERATION MARK
+	0x30AC:  0x30AB,  // KATAKANA LETTER GA
+	0x30AE:  0x30AD,  // KATAKANA LETTER GI
+	0x30B0:  0x30AF,  // KATAKANA LETTER GU
+	0x30B2:  0x30B1,  // KATAKANA LETTER GE
+	0x30B4:  0x30B3,  // KATAKANA LETTER GO
+	0x30B6:  0x30B5,  // KATAKANA LETTER ZA
+	0x30B8:  0x30B7,  // KATAKANA LETTER ZI
+	0x30BA:  0x30B9,  // KATAKANA LETTER ZU
+	0x30BC:  0x30BB,  // KATAKANA LETTER ZE
+	0x30BE:  0x30BD,  // KATAKANA LETTER ZO
+	0x30C0:  0x30BF,  // KATAKANA LETTER DA
+	0x30C2:  0x30C1,  // KATAKANA LETTER DI
+	0x30C5:  0x30C4,  // KATAKANA LETTER DU
+	0x30C7:  0x30C6,  // KATAKANA LETTER DE
+	0x30C9:  0x30C8,  // KATAKANA LETTER DO
+	0x30D0:  0x30CF,  // KATAKANA LETTER BA
+	0x30D1:  0x30CF,  // KATAKANA LETTER PA
+	0x30D3:  0x30D2,  // KATAKANA LETTER BI
+	0x30D4:  0x30D2,  // KATAKANA LETTER PI
+	0x30D6:  0x30D5,  // KATAKANA LETTER BU
+	0x30D7:  0x30D5,  // KATAKANA LETTER PU
+	0x30D9:  0x30D8,  // KATAKANA LETTER BE
+	0x30DA:  0x30D8,  // KATAKANA LETTER PE
+	0x30DC:  0x30DB,  // KATAKANA LETTER BO
+	0x30DD:  0x30DB,  // KATAKANA LETTER PO
+	0x30F4:  0x30A6,  // KATAKANA LETTER VU
+	0x30F7:  0x30EF,  // KATAKANA LETTER VA
+	0x30F8:  0x30F0,  // KATAKANA LETTER VI
+	0x30F9:  0x30F1,  // KATAKANA LETTER VE
+	0x30FA:  0x30F2,  // KATAKANA LETTER VO
+	0x30FE:  0x30FD,  // KATAKANA VOICED ITERATION MARK
+	0xF900:  0x8C48,  // CJK COMPATIBILITY IDEOGRAPH-F900
+	0xF901:  0x66F4,  // CJK COMPATIBILITY IDEOGRAPH-F901
+	0xF902:  0x8ECA,  // CJK COMPATIBILITY IDEOGRAPH-F902
+	0xF903:  0x8CC8,  // CJK COMPATIBILITY IDEOGRAPH-F903
+	0xF904:  0x6ED1,  // CJK COMPATIBILITY IDEOGRAPH-F904
+	0xF905:  0x4E32,  // CJK COMPATIBILITY IDEOGRAPH-F905
+	0xF906:  0x53E5,  // CJK COMPATIBILITY IDEOGRAPH-F906
+	0xF907:  0x9F9C,  // CJK COMPATIBILITY IDEOGRAPH-F907
+	0xF908:  0x9F9C,  // CJK COMPATIBILITY IDEOGRAPH-F908
+	0xF909:  0x5951,  // CJK COMPATIBILITY IDEOGRAPH-F909
+	0xF90A:  0x91D1,  // CJK COMPATIBILITY IDEOGRAPH-F90A
+	0xF90B:  0x5587,  // CJK COMPATIBILITY IDEOGRAPH-F90B
+	0xF90C:  0x5948,  // CJK COMPATIBILITY IDEOGRAPH-F90C
+	0xF90D:  0x61F6,  // CJK COMPATIBILITY IDEOGRAPH-F90D
+	0xF90E:  0x7669,  // CJK COMPATIBILITY IDEOGRAPH-F90E
+	0xF90F:  0x7F85,  // CJK COMPATIBILITY IDEOGRAPH-F90F
+	0xF910:  0x863F,  // CJK COMPATIBILITY IDEOGRAPH-F910
+	0xF911:  0x87BA,  // CJK COMPATIBILITY IDEOGRAPH-F911
+	0xF912:  0x88F8,  // CJK COMPATIBILITY IDEOGRAPH-F912
+	0xF913:  0x908F,  // CJK COMPATIBILITY IDEOGRAPH-F913
+	0xF914:  0x6A02,  // CJK COMPATIBILITY IDEOGRAPH-F914
+	0xF915:  0x6D1B,  // CJK COMPATIBILITY IDEOGRAPH-F915
+	0xF916:  0x70D9,  // CJK COMPATIBILITY IDEOGRAPH-F916
+	0xF917:  0x73DE,  // CJK COMPATIBILITY IDEOGRAPH-F917
+	0xF918:  0x843D,  // CJK COMPATIBILITY IDEOGRAPH-F918
+	0xF919:  0x916A,  // CJK COMPATIBILITY IDEOGRAPH-F919
+	0xF91A:  0x99F1,  // CJK COMPATIBILITY IDEOGRAPH-F91A
+	0xF91B:  0x4E82,  // CJK COMPATIBILITY IDEOGRAPH-F91B
+	0xF91C:  0x5375,  // CJK COMPATIBILITY IDEOGRAPH-F91C
+	0xF91D:  0x6B04,  // CJK COMPATIBILITY IDEOGRAPH-F91D
+	0xF91E:  0x721B,  // CJK COMPATIBILITY IDEOGRAPH-F91E
+	0xF91F:  0x862D,  // CJK COMPATIBILITY IDEOGRAPH-F91F
+	0xF920:  0x9E1E,  // CJK COMPATIBILITY IDEOGRAPH-F920
+	0xF921:  0x5D50,  // CJK COMPATIBILITY IDEOGRAPH-F921
+	0xF922:  0x6FEB,  // CJK COMPATIBILITY IDEOGRAPH-F922
+	0xF923:  0x85CD,  // CJK COMPATIBILITY IDEOGRAPH-F923
+	0xF924:  0x8964,  // CJK COMPATIBILITY IDEOGRAPH-F924
+	0xF925:  0x62C9,  // CJK COMPATIBILITY IDEOGRAPH-F925
+	0xF926:  0x81D8,  // CJK COMPATIBILITY IDEOGRAPH-F926
+	0xF927:  0x881F,  // CJK COMPATIBILITY IDEOGRAPH-F927
+	0xF928:  0x5ECA,  // CJK COMPATIBILITY IDEOGRAPH-F928
+	0xF929:  0x6717,  // CJK COMPATIBILITY IDEOGRAPH-F929
+	0xF92A:  0x6D6A,  // CJK COMPATIBILITY IDEOGRAPH-F92A
+	0xF92B:  0x72FC,  // CJK COMPATIBILITY IDEOGRAPH-F92B
+	0xF92C:  0x90CE,  // CJK COMPATIBILITY IDEOGRAPH-F92C
+	0xF92D:  0x4F86,  // CJK COMPATIBILITY IDEOGRAPH-F92D
+	0xF92E:  0x51B7,  // CJK COMPATIBILITY IDEOGRAPH-F92E
+	0xF92F:  0x52DE,  // CJK COMPATIBILITY IDEOGRAPH-F92F
+	0xF930:  0x64C4,  // CJK COMPATIBILITY IDEOGRAPH-F930
+	0xF931:  0x6AD3,  // CJK COMPATIBILITY IDEOGRAPH-F931
+	0xF932:  0x7210,  // CJK COMPATIBILITY IDEOGRAPH-F932
+	0xF933:  0x76E7,  // CJK COMPATIBILITY IDEOGRAPH-F933
+	0xF934:  0x8001,  // CJK COMPATIBILITY IDEOGRAPH-F934
+	0xF935:  0x8606,  // CJK COMPATIBILITY IDEOGRAPH-F935
+	0xF936:  0x865C,  // CJK COMPATIBILITY IDEOGRAPH-F936
+	0xF937:  0x8DEF,  // CJK COMPATIBILITY IDEOGRAPH-F937
+	0xF938:  0x9732,  // CJK COMPATIBILITY IDEOGRAPH-F938
+	0xF939:  0x9B6F,  // CJK COMPATIBILITY IDEOGRAPH-F939
+	0xF93A:  0x9DFA,  // CJK COMPATIBILITY IDEOGRAPH-F93A
+	0xF93B:  0x788C,  // CJK COMPATIBILITY IDEOGRAPH-F93B
+	0xF93C:  0x797F,  // CJK COMPATIBILITY IDEOGRAPH-F93C
+	0xF93D:  0x7DA0,  // CJK COMPATIBILITY IDEOGRAPH-F93D
+	0xF93E:  0x83C9,  // CJK COMPATIBILITY IDEOGRAPH-F93E
+	0xF93F:  0x9304,  // CJK COMPATIBILITY IDEOGRAPH-F93F
+	0xF940:  0x9E7F,  // CJK COMPATIBILITY IDEOGRAPH-F940
+	0xF941:  0x8AD6,  // CJK COMPATIBILITY IDEOGRAPH-F941
+	0xF942:  0x58DF,  // CJK COMPATIBILITY IDEOGRAPH-F942
+	0xF943:  0x5F04,  // CJK COMPATIBILITY IDEOGRAPH-F943
+	0xF944:  0x7C60,  // CJK COMPATIBILITY IDEOGRAPH-F944
+	0xF945:  0x807E,  // CJK COMPATIBILITY IDEOGRAPH-F945
+	0xF946:  0x7262,  // CJK COMPATIBILITY IDEOGRAPH-F946
+	0xF947:  0x78CA,  // CJK COMPATIBILITY IDEOGRAPH-F947
+	0xF948:  0x8CC2,  // CJK COMPATIBILITY IDEOGRAPH-F948
+	0xF949:  0x96F7,  // CJK COMPATIBILITY IDEOGRAPH-F949
+	0xF94A:  0x58D8,  // CJK COMPATIBILITY IDEOGRAPH-F94A
+	0xF94B:  0x5C62,  // CJK COMPATIBILITY IDEOGRAPH-F94B
+	0xF94C:  0x6A13,  // CJK COMPATIBILITY IDEOGRAPH-F94C
+	0xF94D:  0x6DDA,  // CJK COMPATIBILITY IDEOGRAPH-F94D
+	0xF94E:  0x6F0F,  // CJK COMPATIBILITY IDEOGRAPH-F94E
+	0xF94F:  0x7D2F,  // CJK COMPATIBILITY IDEOGRAPH-F94F
+	0xF950:  0x7E37,  // CJK COMPATIBILITY IDEOGRAPH-F950
+	0xF951:  0x964B,  // CJK COMPATIBILITY IDEOGRAPH-F951
+	0xF952:  0x52D2,  // CJK COMPATIBILITY IDEOGRAPH-F952
+	0xF953:  0x808B,  // CJK COMPATIBILITY IDEOGRAPH-F953
+	0xF954:  0x51DC,  // CJK COMPATIBILITY IDEOGRAPH-F954
+	0xF955:  0x51CC,  // CJK COMPATIBILITY IDEOGRAPH-F955
+	0xF956:  0x7A1C,  // CJK COMPATIBILITY IDEOGRAPH-F956
+	0xF957:  0x7DBE,  // CJK COMPATIBILITY IDEOGRAPH-F957
+	0xF958:  0x83F1,  // CJK COMPATIBILITY IDEOGRAPH-F958
+	0xF959:  0x9675,  // CJK COMPATIBILITY IDEOGRAPH-F959
+	0xF95A:  0x8B80,  // CJK COMPATIBILITY IDEOGRAPH-F95A
+	0xF95B:  0x62CF,  // CJK COMPATIBILITY IDEOGRAPH-F95B
+	0xF95C:  0x6A02,  // CJK COMPATIBILITY IDEOGRAPH-F95C
+	0xF95D:  0x8AFE,  // CJK COMPATIBILITY IDEOGRAPH-F95D
+	0xF95E:  0x4E39,  // CJK COMPATIBILITY IDEOGRAPH-F95E
+	0xF95F:  0x5BE7,  // CJK COMPATIBILITY IDEOGRAPH-F95F
+	0xF960:  0x6012,  // CJK COMPATIBILITY IDEOGRAPH-F960
+	0xF961:  0x7387,  // CJK COMPATIBILITY IDEOGRAPH-F961
+	0xF962:  0x7570,  // CJK COMPATIBILITY IDEOGRAPH-F962
+	0xF963:  0x5317,  // CJK COMPATIBILITY IDEOGRAPH-F963
+	0xF964:  0x78FB,  // CJK COMPATIBILITY IDEOGRAPH-F964
+	0xF965:  0x4FBF,  // CJK COMPATIBILITY IDEOGRAPH-F965
+	0xF966:  0x5FA9,  // CJK COMPATIBILITY IDEOGRAPH-F966
+	0xF967:  0x4E0D,  // CJK COMPATIBILITY IDEOGRAPH-F967
+	0xF968:  0x6CCC,  // CJK COMPATIBILITY IDEOGRAPH-F968
+	0xF969:  0x6578,  // CJK COMPATIBILITY IDEOGRAPH-F969
+	0xF96A:  0x7D22,  // CJK COMPATIBILITY IDEOGRAPH-F96A
+	0xF96B:  0x53C3,  // CJK COMPATIBILITY IDEOGRAPH-F96B
+	0xF96C:  0x585E,  // CJK COMPATIBILITY IDEOGRAPH-F96C
+	0xF96D:  0x7701,  // CJK COMPATIBILITY IDEOGRAPH-F96D
+	0xF96E:  0x8449,  // CJK COMPATIBILITY IDEOGRAPH-F96E
+	0xF96F:  0x8AAA,  // CJK COMPATIBILITY IDEOGRAPH-F96F
+	0xF970:  0x6BBA,  // CJK COMPATIBILITY IDEOGRAPH-F970
+	0xF971:  0x8FB0,  // CJK COMPATIBILITY IDEOGRAPH-F971
+	0xF972:  0x6C88,  // CJK COMPATIBILITY IDEOGRAPH-F972
+	0xF973:  0x62FE,  // CJK COMPATIBILITY IDEOGRAPH-F973
+	0xF974:  0x82E5,  // CJK COMPATIBILITY IDEOGRAPH-F974
+	0xF975:  0x63A0,  // CJK COMPATIBILITY IDEOGRAPH-F975
+	0xF976:  0x7565,  // CJK COMPATIBILITY IDEOGRAPH-F976
+	0xF977:  0x4EAE,  // CJK COMPATIBILITY IDEOGRAPH-F977
+	0xF978:  0x5169,  // CJK COMPATIBILITY IDEOGRAPH-F978
+	0xF979:  0x51C9,  // CJK COMPATIBILITY IDEOGRAPH-F979
+	0xF97A:  0x6881,  // CJK COMPATIBILITY IDEOGRAPH-F97A
+	0xF97B:  0x7CE7,  // CJK COMPATIBILITY IDEOGRAPH-F97B
+	0xF97C:  0x826F,  // CJK COMPATIBILITY IDEOGRAPH-F97C
+	0xF97D:  0x8AD2,  // CJK COMPATIBILITY IDEOGRAPH-F97D
+	0xF97E:  0x91CF,  // CJK COMPATIBILITY IDEOGRAPH-F97E
+	0xF97F:  0x52F5,  // CJK COMPATIBILITY IDEOGRAPH-F97F
+	0xF980:  0x5442,  // CJK COMPATIBILITY IDEOGRAPH-F980
+	0xF981:  0x5973,  // CJK COMPATIBILITY IDEOGRAPH-F981
+	0xF982:  0x5EEC,  // CJK COMPATIBILITY IDEOGRAPH-F982
+	0xF983:  0x65C5,  // CJK COMPATIBILITY IDEOGRAPH-F983
+	0xF984:  0x6FFE,  // CJK COMPATIBILITY IDEOGRAPH-F984
+	0xF985:  0x792A,  // CJK COMPATIBILITY IDEOGRAPH-F985
+	0xF986:  0x95AD,  // CJK COMPATIBILITY IDEOGRAPH-F986
+	0xF987:  0x9A6A,  // CJK COMPATIBILITY IDEOGRAPH-F987
+	0xF988:  0x9E97,  // CJK COMPATIBILITY IDEOGRAPH-F988
+	0xF989:  0x9ECE,  // CJK COMPATIBILITY IDEOGRAPH-F989
+	0xF98A:  0x529B,  // CJK COMPATIBILITY IDEOGRAPH-F98A
+	0xF98B:  0x66C6,  // CJK COMPATIBILITY IDEOGRAPH-F98B
+	0xF98C:  0x6B77,  // CJK COMPATIBILITY IDEOGRAPH-F98C
+	0xF98D:  0x8F62,  // CJK COMPATIBILITY IDEOGRAPH-F98D
+	0xF98E:  0x5E74,  // CJK COMPATIBILITY IDEOGRAPH-F98E
+	0xF98F:  0x6190,  // CJK COMPATIBILITY IDEOGRAPH-F98F
+	0xF990:  0x6200,  // CJK COMPATIBILITY IDEOGRAPH-F990
+	0xF991:  0x649A,  // CJK COMPATIBILITY IDEOGRAPH-F991
+	0xF992:  0x6F23,  // CJK COMPATIBILITY IDEOGRAPH-F992
+	0xF993:  0x7149,  // CJK COMPATIBILITY IDEOGRAPH-F993
+	0xF994:  0x7489,  // CJK COMPATIBILITY IDEOGRAPH-F994
+	0xF995:  0x79CA,  // CJK COMPATIBILITY IDEOGRAPH-F995
+	0xF996:  0x7DF4,  // CJK COMPATIBILITY IDEOGRAPH-F996
+	0xF997:  0x806F,  // CJK COMPATIBILITY IDEOGRAPH-F997
+	0xF998:  0x8F26,  // CJK COMPATIBILITY IDEOGRAPH-F998
+	0xF999:  0x84EE,  // CJK COMPATIBILITY IDEOGRAPH-F999
+	0xF99A:  0x9023,  // CJK COMPATIBILITY IDEOGRAPH-F99A
+	0xF99B:  0x934A,  // CJK COMPATIBILITY IDEOGRAPH-F99B
+	0xF99C:  0x5217,  // CJK COMPATIBILITY IDEOGRAPH-F99C
+	0xF99D:  0x52A3,  // CJK COMPATIBILITY IDEOGRAPH-F99D
+	0xF99E:  0x54BD,  // CJK COMPATIBILITY IDEOGRAPH-F99E
+	0xF99F:  0x70C8,  // CJK COMPATIBILITY IDEOGRAPH-F99F
+	0xF9A0:  0x88C2,  // CJK COMPATIBILITY IDEOGRAPH-F9A0
+	0xF9A1:  0x8AAA,  // CJK COMPATIBILITY IDEOGRAPH-F9A1
+	0xF9A2:  0x5EC9,  // CJK COMPATIBILITY IDEOGRAPH-F9A2
+	0xF9A3:  0x5FF5,  // CJK COMPATIBILITY IDEOGRAPH-F9A3
+	0xF9A4:  0x637B,  // CJK COMPATIBILITY IDEOGRAPH-F9A4
+	0xF9A5:  0x6BAE,  // CJK COMPATIBILITY IDEOGRAPH-F9A5
+	0xF9A6:  0x7C3E,  // CJK COMPATIBILITY IDEOGRAPH-F9A6
+	0xF9A7:  0x7375,  // CJK COMPATIBILITY IDEOGRAPH-F9A7
+	0xF9A8:  0x4EE4,  // CJK COMPATIBILITY IDEOGRAPH-F9A8
+	0xF9A9:  0x56F9,  // CJK COMPATIBILITY IDEOGRAPH-F9A9
+	0xF9AA:  0x5BE7,  // CJK COMPATIBILITY IDEOGRAPH-F9AA
+	0xF9AB:  0x5DBA,  // CJK COMPATIBILITY IDEOGRAPH-F9AB
+	0xF9AC:  0x601C,  // CJK COMPATIBILITY IDEOGRAPH-F9AC
+	0xF9AD:  0x73B2,  // CJK COMPATIBILITY IDEOGRAPH-F9AD
+	0xF9AE:  0x7469,  // CJK COMPATIBILITY IDEOGRAPH-F9AE
+	0xF9AF:  0x7F9A,  // CJK COMPATIBILITY IDEOGRAPH-F9AF
+	0xF9B0:  0x8046,  // CJK COMPATIBILITY IDEOGRAPH-F9B0
+	0xF9B1:  0x9234,  // CJK COMPATIBILITY IDEOGRAPH-F9B1
+	0xF9B2:  0x96F6,  // CJK COMPATIBILITY IDEOGRAPH-F9B2
+	0xF9B3:  0x9748,  // CJK COMPATIBILITY IDEOGRAPH-F9B3
+	0xF9B4:  0x9818,  // CJK COMPATIBILITY IDEOGRAPH-F9B4
+	0xF9B5:  0x4F8B,  // CJK COMPATIBILITY IDEOGRAPH-F9B5
+	0xF9B6:  0x79AE,  // CJK COMPATIBILITY IDEOGRAPH-F9B6
+	0xF9B7:  0x91B4,  // CJK COMPATIBILITY IDEOGRAPH-F9B7
+	0xF9B8:  0x96B8,  // CJK COMPATIBILITY IDEOGRAPH-F9B8
+	0xF9B9:  0x60E1,  // CJK COMPATIBILITY IDEOGRAPH-F9B9
+	0xF9BA:  0x4E86,  // CJK COMPATIBILITY IDEOGRAPH-F9BA
+	0xF9BB:  0x50DA,  // CJK COMPATIBILITY IDEOGRAPH-F9BB
+	0xF9BC:  0x5BEE,  // CJK COMPATIBILITY IDEOGRAPH-F9BC
+	0xF9BD:  0x5C3F,  // CJK COMPATIBILITY IDEOGRAPH-F9BD
+	0xF9BE:  0x6599,  // CJK COMPATIBILITY IDEOGRAPH-F9BE
+	0xF9BF:  0x6A02,  // CJK COMPATIBILITY IDEOGRAPH-F9BF
+	0xF9C0:  0x71CE,  // CJK COMPATIBILITY IDEOGRAPH-F9C0
+	0xF9C1:  0x7642,  // CJK COMPATIBILITY IDEOGRAPH-F9C1
+	0xF9C2:  0x84FC,  // CJK COMPATIBILITY IDEOGRAPH-F9C2
+	0xF9C3:  0x907C,  // CJK COMPATIBILITY IDEOGRAPH-F9C3
+	0xF9C4:  0x9F8D,  // CJK COMPATIBILITY IDEOGRAPH-F9C4
+	0xF9C5:  0x6688,  // CJK COMPATIBILITY IDEOGRAPH-F9C5
+	0xF9C6:  0x962E,  // CJK COMPATIBILITY IDEOGRAPH-F9C6
+	0xF9C7:  0x5289,  // CJK COMPATIBILITY IDEOGRAPH-F9C7
+	0xF9C8:  0x677B,  // CJK COMPATIBILITY IDEOGRAPH-F9C8
+	0xF9C9:  0x67F3,  // CJK COMPATIBILITY IDEOGRAPH-F9C9
+	0xF9CA:  0x6D41,  // CJK COMPATIBILITY IDEOGRAPH-F9CA
+	0xF9CB:  0x6E9C,  // CJK COMPATIBILITY IDEOGRAPH-F9CB
+	0xF9CC:  0x7409,  // CJK COMPATIBILITY IDEOGRAPH-F9CC
+	0xF9CD:  0x7559,  // CJK COMPATIBILITY IDEOGRAPH-F9CD
+	0xF9CE:  0x786B,  // CJK COMPATIBILITY IDEOGRAPH-F9CE
+	0xF9CF:  0x7D10,  // CJK COMPATIBILITY IDEOGRAPH-F9CF
+	0xF9D0:  0x985E,  // CJK COMPATIBILITY IDEOGRAPH-F9D0
+	0xF9D1:  0x516D,  // CJK COMPATIBILITY IDEOGRAPH-F9D1
+	0xF9D2:  0x622E,  // CJK COMPATIBILITY IDEOGRAPH-F9D2
+	0xF9D3:  0x9678,  // CJK COMPATIBILITY IDEOGRAPH-F9D3
+	0xF9D4:  0x502B,  // CJK COMPATIBILITY IDEOGRAPH-F9D4
+	0xF9D5:  0x5D19,  // CJK COMPATIBILITY IDEOGRAPH-F9D5
+	0xF9D6:  0x6DEA,  // CJK COMPATIBILITY IDEOGRAPH-F9D6
+	0xF9D7:  0x8F2A,  // CJK COMPATIBILITY IDEOGRAPH-F9D7
+	0xF9D8:  0x5F8B,  // CJK COMPATIBILITY IDEOGRAPH-F9D8
+	0xF9D9:  0x6144,  // CJK COMPATIBILITY IDEOGRAPH-F9D9
+	0xF9DA:  0x6817,  // CJK COMPATIBILITY IDEOGRAPH-F9DA
+	0xF9DB:  0x7387,  // CJK COMPATIBILITY IDEOGRAPH-F9DB
+	0xF9DC:  0x9686,  // CJK COMPATIBILITY IDEOGRAPH-F9DC
+	0xF9DD:  0x5229,  // CJK COMPATIBILITY IDEOGRAPH-F9DD
+	0xF9DE:  0x540F,  // CJK COMPATIBILITY IDEOGRAPH-F9DE
+	0xF9DF:  0x5C65,  // CJK COMPATIBILITY IDEOGRAPH-F9DF
+	0xF9E0:  0x6613,  // CJK COMPATIBILITY IDEOGRAPH-F9E0
+	0xF9E1:  0x674E,  // CJK COMPATIBILITY IDEOGRAPH-F9E1
+	0xF9E2:  0x68A8,  // CJK COMPATIBILITY IDEOGRAPH-F9E2
+	0xF9E3:  0x6CE5,  // CJK COMPATIBILITY IDEOGRAPH-F9E3
+	0xF9E4:  0x7406,  // CJK COMPATIBILITY IDEOGRAPH-F9E4
+	0xF9E5:  0x75E2,  // CJK COMPATIBILITY IDEOGRAPH-F9E5
+	0xF9E6:  0x7F79,  // CJK COMPATIBILITY IDEOGRAPH-F9E6
+	0xF9E7:  0x88CF,  // CJK COMPATIBILITY IDEOGRAPH-F9E7
+	0xF9E8:  0x88E1,  // CJK COMPATIBILITY IDEOGRAPH-F9E8
+	0xF9E9:  0x91CC,  // CJK COMPATIBILITY IDEOGRAPH-F9E9
+	0xF9EA:  0x96E2,  // CJK COMPATIBILITY IDEOGRAPH-F9EA
+	0xF9EB:  0x533F,  // CJK COMPATIBILITY IDEOGRAPH-F9EB
+	0xF9EC:  0x6EBA,  // CJK COMPATIBILITY IDEOGRAPH-F9EC
+	0xF9ED:  0x541D,  // CJK COMPATIBILITY IDEOGRAPH-F9ED
+	0xF9EE:  0x71D0,  // CJK COMPATIBILITY IDEOGRAPH-F9EE
+	0xF9EF:  0x7498,  // CJK COMPATIBILITY IDEOGRAPH-F9EF
+	0xF9F0:  0x85FA,  // CJK COMPATIBILITY IDEOGRAPH-F9F0
+	0xF9F1:  0x96A3,  // CJK COMPATIBILITY IDEOGRAPH-F9F1
+	0xF9F2:  0x9C57,  // CJK COMPATIBILITY IDEOGRAPH-F9F2
+	0xF9F3:  0x9E9F,  // CJK COMPATIBILITY IDEOGRAPH-F9F3
+	0xF9F4:  0x6797,  // CJK COMPATIBILITY IDEOGRAPH-F9F4
+	0xF9F5:  0x6DCB,  // CJK COMPATIBILITY IDEOGRAPH-F9F5
+	0xF9F6:  0x81E8,  // CJK COMPATIBILITY IDEOGRAPH-F9F6
+	0xF9F7:  0x7ACB,  // CJK COMPATIBILITY IDEOGRAPH-F9F7
+	0xF9F8:  0x7B20,  // CJK COMPATIBILITY IDEOGRAPH-F9F8
+	0xF9F9:  0x7C92,  // CJK COMPATIBILITY IDEOGRAPH-F9F9
+	0xF9FA:  0x72C0,  // CJK COMPATIBILITY IDEOGRAPH-F9FA
+	0xF9FB:  0x7099,  // CJK COMPATIBILITY IDEOGRAPH-F9FB
+	0xF9FC:  0x8B58,  // CJK COMPATIBILITY IDEOGRAPH-F9FC
+	0xF9FD:  0x4EC0,  // CJK COMPATIBILITY IDEOGRAPH-F9FD
+	0xF9FE:  0x8336,  // CJK COMPATIBILITY IDEOGRAPH-F9FE
+	0xF9FF:  0x523A,  // CJK COMPATIBILITY IDEOGRAPH-F9FF
+	0xFA00:  0x5207,  // CJK COMPATIBILITY IDEOGRAPH-FA00
+	0xFA01:  0x5EA6,  // CJK COMPATIBILITY IDEOGRAPH-FA01
+	0xFA02:  0x62D3,  // CJK COMPATIBILITY IDEOGRAPH-FA02
+	0xFA03:  0x7CD6,  // CJK COMPATIBILITY IDEOGRAPH-FA03
+	0xFA04:  0x5B85,  // CJK COMPATIBILITY IDEOGRAPH-FA04
+	0xFA05:  0x6D1E,  // CJK COMPATIBILITY IDEOGRAPH-FA05
+	0xFA06:  0x66B4,  // CJK COMPATIBILITY IDEOGRAPH-FA06
+	0xFA07:  0x8F3B,  // CJK COMPATIBILITY IDEOGRAPH-FA07
+	0xFA08:  0x884C,  // CJK COMPATIBILITY IDEOGRAPH-FA08
+	0xFA09:  0x964D,  // CJK COMPATIBILITY IDEOGRAPH-FA09
+	0xFA0A:  0x898B,  // CJK COMPATIBILITY IDEOGRAPH-FA0A
+	0xFA0B:  0x5ED3,  // CJK COMPATIBILITY IDEOGRAPH-FA0B
+	0xFA0C:  0x5140,  // CJK COMPATIBILITY IDEOGRAPH-FA0C
+	0xFA0D:  0x55C0,  // CJK COMPATIBILITY IDEOGRAPH-FA0D
+	0xFA10:  0x585A,  // CJK COMPATIBILITY IDEOGRAPH-FA10
+	0xFA12:  0x6674,  // CJK COMPATIBILITY IDEOGRAPH-FA12
+	0xFA15:  0x51DE,  // CJK COMPATIBILITY IDEOGRAPH-FA15
+	0xFA16:  0x732A,  // CJK COMPATIBILITY IDEOGRAPH-FA16
+	0xFA17:  0x76CA,  // CJK COMPATIBILITY IDEOGRAPH-FA17
+	0xFA18:  0x793C,  // CJK COMPATIBILITY IDEOGRAPH-FA18
+	0xFA19:  0x795E,  // CJK COMPATIBILITY IDEOGRAPH-FA19
+	0xFA1A:  0x7965,  // CJK COMPATIBILITY IDEOGRAPH-FA1A
+	0xFA1B:  0x798F,  // CJK COMPATIBILITY IDEOGRAPH-FA1B
+	0xFA1C:  0x9756,  // CJK COMPATIBILITY IDEOGRAPH-FA1C
+	0xFA1D:  0x7CBE,  // CJK COMPATIBILITY IDEOGRAPH-FA1D
+	0xFA1E:  0x7FBD,  // CJK COMPATIBILITY IDEOGRAPH-FA1E
+	0xFA20:  0x8612,  // CJK COMPATIBILITY IDEOGRAPH-FA20
+	0xFA22:  0x8AF8,  // CJK COMPATIBILITY IDEOGRAPH-FA22
+	0xFA25:  0x9038,  // CJK COMPATIBILITY IDEOGRAPH-FA25
+	0xFA26:  0x90FD,  // CJK COMPATIBILITY IDEOGRAPH-FA26
+	0xFA2A:  0x98EF,  // CJK COMPATIBILITY IDEOGRAPH-FA2A
+	0xFA2B:  0x98FC,  // CJK COMPATIBILITY IDEOGRAPH-FA2B
+	0xFA2C:  0x9928,  // CJK COMPATIBILITY IDEOGRAPH-FA2C
+	0xFA2D:  0x9DB4,  // CJK COMPATIBILITY IDEOGRAPH-FA2D
+	0xFA2E:  0x90DE,  // CJK COMPATIBILITY IDEOGRAPH-FA2E
+	0xFA2F:  0x96B7,  // CJK COMPATIBILITY IDEOGRAPH-FA2F
+	0xFA30:  0x4FAE,  // CJK COMPATIBILITY IDEOGRAPH-FA30
+	0xFA31:  0x50E7,  // CJK COMPATIBILITY IDEOGRAPH-FA31
+	0xFA32:  0x514D,  // CJK COMPATIBILITY IDEOGRAPH-FA32
+	0xFA33:  0x52C9,  // CJK COMPATIBILITY IDEOGRAPH-FA33
+	0xFA34:  0x52E4,  // CJK COMPATIBILITY IDEOGRAPH-FA34
+	0xFA35:  0x5351,  // CJK COMPATIBILITY IDEOGRAPH-FA35
+	0xFA36:  0x559D,  // CJK COMPATIBILITY IDEOGRAPH-FA36
+	0xFA37:  0x5606,  // CJK COMPATIBILITY IDEOGRAPH-FA37
+	0xFA38:  0x5668,  // CJK COMPATIBILITY IDEOGRAPH-FA38
+	0xFA39:  0x5840,  // CJK COMPATIBILITY IDEOGRAPH-FA39
+	0xFA3A:  0x58A8,  // CJK COMPATIBILITY IDEOGRAPH-FA3A
+	0xFA3B:  0x5C64,  // CJK COMPATIBILITY IDEOGRAPH-FA3B
+	0xFA3C:  0x5C6E,  // CJK COMPATIBILITY IDEOGRAPH-FA3C
+	0xFA3D:  0x6094,  // CJK COMPATIBILITY IDEOGRAPH-FA3D
+	0xFA3E:  0x6168,  // CJK COMPATIBILITY IDEOGRAPH-FA3E
+	0xFA3F:  0x618E,  // CJK COMPATIBILITY IDEOGRAPH-FA3F
+	0xFA40:  0x61F2,  // CJK COMPATIBILITY IDEOGRAPH-FA40
+	0xFA41:  0x654F,  // CJK COMPATIBILITY IDEOGRAPH-FA41
+	0xFA42:  0x65E2,  // CJK COMPATIBILITY IDEOGRAPH-FA42
+	0xFA43:  0x6691,  // CJK COMPATIBILITY IDEOGRAPH-FA43
+	0xFA44:  0x6885,  // CJK COMPATIBILITY IDEOGRAPH-FA44
+	0xFA45:  0x6D77,  // CJK COMPATIBILITY IDEOGRAPH-FA45
+	0xFA46:  0x6E1A,  // CJK COMPATIBILITY IDEOGRAPH-FA46
+	0xFA47:  0x6F22,  // CJK COMPATIBILITY IDEOGRAPH-FA47
+	0xFA48:  0x716E,  // CJK COMPATIBILITY IDEOGRAPH-FA48
+	0xFA49:  0x722B,  // CJK COMPATIBILITY IDEOGRAPH-FA49
+	0xFA4A:  0x7422,  // CJK COMPATIBILITY IDEOGRAPH-FA4A
+	0xFA4B:  0x7891,  // CJK COMPATIBILITY IDEOGRAPH-FA4B
+	0xFA4C:  0x793E,  // CJK COMPATIBILITY IDEOGRAPH-FA4C
+	0xFA4D:  0x7949,  // CJK COMPATIBILITY IDEOGRAPH-FA4D
+	0xFA4E:  0x7948,  // CJK COMPATIBILITY IDEOGRAPH-FA4E
+	0xFA4F:  0x7950,  // CJK COMPATIBILITY IDEOGRAPH-FA4F
+	0xFA50:  0x7956,  // CJK COMPATIBILITY IDEOGRAPH-FA50
+	0xFA51:  0x795D,  // CJK COMPATIBILITY IDEOGRAPH-FA51
+	0xFA52:  0x798D,  // CJK COMPATIBILITY IDEOGRAPH-FA52
+	0xFA53:  0x798E,  // CJK COMPATIBILITY IDEOGRAPH-FA53
+	0xFA54:  0x7A40,  // CJK COMPATIBILITY IDEOGRAPH-FA54
+	0xFA55:  0x7A81,  // CJK COMPATIBILITY IDEOGRAPH-FA55
+	0xFA56:  0x7BC0,  // CJK COMPATIBILITY IDEOGRAPH-FA56
+	0xFA57:  0x7DF4,  // CJK COMPATIBILITY IDEOGRAPH-FA57
+	0xFA58:  0x7E09,  // CJK COMPATIBILITY IDEOGRAPH-FA58
+	0xFA59:  0x7E41,  // CJK COMPATIBILITY IDEOGRAPH-FA59
+	0xFA5A:  0x7F72,  // CJK COMPATIBILITY IDEOGRAPH-FA5A
+	0xFA5B:  0x8005,  // CJK COMPATIBILITY IDEOGRAPH-FA5B
+	0xFA5C:  0x81ED,  // CJK COMPATIBILITY IDEOGRAPH-FA5C
+	0xFA5D:  0x8279,  // CJK COMPATIBILITY IDEOGRAPH-FA5D
+	0xFA5E:  0x8279,  // CJK COMPATIBILITY IDEOGRAPH-FA5E
+	0xFA5F:  0x8457,  // CJK COMPATIBILITY IDEOGRAPH-FA5F
+	0xFA60:  0x8910,  // CJK COMPATIBILITY IDEOGRAPH-FA60
+	0xFA61:  0x8996,  // CJK COMPATIBILITY IDEOGRAPH-FA61
+	0xFA62:  0x8B01,  // CJK COMPATIBILITY IDEOGRAPH-FA62
+	0xFA63:  0x8B39,  // CJK COMPATIBILITY IDEOGRAPH-FA63
+	0xFA64:  0x8CD3,  // CJK COMPATIBILITY IDEOGRAPH-FA64
+	0xFA65:  0x8D08,  // CJK COMPATIBILITY IDEOGRAPH-FA65
+	0xFA66:  0x8FB6,  // CJK COMPATIBILITY IDEOGRAPH-FA66
+	0xFA67:  0x9038,  // CJK COMPATIBILITY IDEOGRAPH-FA67
+	0xFA68:  0x96E3,  // CJK COMPATIBILITY IDEOGRAPH-FA68
+	0xFA69:  0x97FF,  // CJK COMPATIBILITY IDEOGRAPH-FA69
+	0xFA6A:  0x983B,  // CJK COMPATIBILITY IDEOGRAPH-FA6A
+	0xFA6B:  0x6075,  // CJK COMPATIBILITY IDEOGRAPH-FA6B
+	0xFA6C:  0x242EE, // CJK COMPATIBILITY IDEOGRAPH-FA6C
+	0xFA6D:  0x8218,  // CJK COMPATIBILITY IDEOGRAPH-FA6D
+	0xFA70:  0x4E26,  // CJK COMPATIBILITY IDEOGRAPH-FA70
+	0xFA71:  0x51B5,  // CJK COMPATIBILITY IDEOGRAPH-FA71
+	0xFA72:  0x5168,  // CJK COMPATIBILITY IDEOGRAPH-FA72
+	0xFA73:  0x4F80,  // CJK COMPATIBILITY IDEOGRAPH-FA73
+	0xFA74:  0x5145,  // CJK COMPATIBILITY IDEOGRAPH-FA74
+	0xFA75:  0x5180,  // CJK COMPATIBILITY IDEOGRAPH-FA75
+	0xFA76:  0x52C7,  // CJK COMPATIBILITY IDEOGRAPH-FA76
+	0xFA77:  0x52FA,  // CJK COMPATIBILITY IDEOGRAPH-FA77
+	0xFA78:  0x559D,  // CJK COMPATIBILITY IDEOGRAPH-FA78
+	0xFA79:  0x5555,  // CJK COMPATIBILITY IDEOGRAPH-FA79
+	0xFA7A:  0x5599,  // CJK COMPATIBILITY IDEOGRAPH-FA7A
+	0xFA7B:  0x55E2,  // CJK COMPATIBILITY IDEOGRAPH-FA7B
+	0xFA7C:  0x585A,  // CJK COMPATIBILITY IDEOGRAPH-FA7C
+	0xFA7D:  0x58B3,  // CJK COMPATIBILITY IDEOGRAPH-FA7D
+	0xFA7E:  0x5944,  // CJK COMPATIBILITY IDEOGRAPH-FA7E
+	0xFA7F:  0x5954,  // CJK COMPATIBILITY IDEOGRAPH-FA7F
+	0xFA80:  0x5A62,  // CJK COMPATIBILITY IDEOGRAPH-FA80
+	0xFA81:  0x5B28,  // CJK COMPATIBILITY IDEOGRAPH-FA81
+	0xFA82:  0x5ED2,  // CJK COMPATIBILITY IDEOGRAPH-FA82
+	0xFA83:  0x5ED9,  // CJK COMPATIBILITY IDEOGRAPH-FA83
+	0xFA84:  0x5F69,  // CJK COMPATIBILITY IDEOGRAPH-FA84
+	0xFA85:  0x5FAD,  // CJK COMPATIBILITY IDEOGRAPH-FA85
+	0xFA86:  0x60D8,  // CJK COMPATIBILITY IDEOGRAPH-FA86
+	0xFA87:  0x614E,  // CJK COMPATIBILITY IDEOGRAPH-FA87
+	0xFA88:  0x6108,  // CJK COMPATIBILITY IDEOGRAPH-FA88
+	0xFA89:  0x618E,  // CJK COMPATIBILITY IDEOGRAPH-FA89
+	0xFA8A:  0x6160,  // CJK COMPATIBILITY IDEOGRAPH-FA8A
+	0xFA8B:  0x61F2,  // CJK COMPATIBILITY IDEOGRAPH-FA8B
+	0xFA8C:  0x6234,  // CJK COMPATIBILITY IDEOGRAPH-FA8C
+	0xFA8D:  0x63C4,  // CJK COMPATIBILITY IDEOGRAPH-FA8D
+	0xFA8E:  0x641C,  // CJK COMPATIBILITY IDEOGRAPH-FA8E
+	0xFA8F:  0x6452,  // CJK COMPATIBILITY IDEOGRAPH-FA8F
+	0xFA90:  0x6556,  // CJK COMPATIBILITY IDEOGRAPH-FA90
+	0xFA91:  0x6674,  // CJK COMPATIBILITY IDEOGRAPH-FA91
+	0xFA92:  0x6717,  // CJK COMPATIBILITY IDEOGRAPH-FA92
+	0xFA93:  0x671B,  // CJK COMPATIBILITY IDEOGRAPH-FA93
+	0xFA94:  0x6756,  // CJK COMPATIBILITY IDEOGRAPH-FA94
+	0xFA95:  0x6B79,  // CJK COMPATIBILITY IDEOGRAPH-FA95
+	0xFA96:  0x6BBA,  // CJK COMPATIBILITY IDEOGRAPH-FA96
+	0xFA97:  0x6D41,  // CJK COMPATIBILITY IDEOGRAPH-FA97
+	0xFA98:  0x6EDB,  // CJK COMPATIBILITY IDEOGRAPH-FA98
+	0xFA99:  0x6ECB,  // CJK COMPATIBILITY IDEOGRAPH-FA99
+	0xFA9A:  0x6F22,  // CJK COMPATIBILITY IDEOGRAPH-FA9A
+	0xFA9B:  0x701E,  // CJK COMPATIBILITY IDEOGRAPH-FA9B
+	0xFA9C:  0x716E,  // CJK COMPATIBILITY IDEOGRAPH-FA9C
+	0xFA9D:  0x77A7,  // CJK COMPATIBILITY IDEOGRAPH-FA9D
+	0xFA9E:  0x7235,  // CJK COMPATIBILITY IDEOGRAPH-FA9E
+	0xFA9F:  0x72AF,  // CJK COMPATIBILITY IDEOGRAPH-FA9F
+	0xFAA0:  0x732A,  // CJK COMPATIBILITY IDEOGRAPH-FAA0
+	0xFAA1:  0x7471,  // CJK COMPATIBILITY IDEOGRAPH-FAA1
+	0xFAA2:  0x7506,  // CJK COMPATIBILITY IDEOGRAPH-FAA2
+	0xFAA3:  0x753B,  // CJK COMPATIBILITY IDEOGRAPH-FAA3
+	0xFAA4:  0x761D,  // CJK COMPATIBILITY IDEOGRAPH-FAA4
+	0xFAA5:  0x761F,  // CJK COMPATIBILITY IDEOGRAPH-FAA5
+	0xFAA6:  0x76CA,  // CJK COMPATIBILITY IDEOGRAPH-FAA6
+	0xFAA7:  0x76DB,  // CJK COMPATIBILITY IDEOGRAPH-FAA7
+	0xFAA8:  0x76F4,  // CJK COMPATIBILITY IDEOGRAPH-FAA8
+	0xFAA9:  0x774A,  // CJK COMPATIBILITY IDEOGRAPH-FAA9
+	0xFAAA:  0x7740,  // CJK COMPATIBILITY IDEOGRAPH-FAAA
+	0xFAAB:  0x78CC,  // CJK COMPATIBILITY IDEOGRAPH-FAAB
+	0xFAAC:  0x7AB1,  // CJK COMPATIBILITY IDEOGRAPH-FAAC
+	0xFAAD:  0x7BC0,  // CJK COMPATIBILITY IDEOGRAPH-FAAD
+	0xFAAE:  0x7C7B,  // CJK COMPATIBILITY IDEOGRAPH-FAAE
+	0xFAAF:  0x7D5B,  // CJK COMPATIBILITY IDEOGRAPH-FAAF
+	0xFAB0:  0x7DF4,  // CJK COMPATIBILITY IDEOGRAPH-FAB0
+	0xFAB1:  0x7F3E,  // CJK COMPATIBILITY IDEOGRAPH-FAB1
+	0xFAB2:  0x8005,  // CJK COMPATIBILITY IDEOGRAPH-FAB2
+	0xFAB3:  0x8352,  // CJK COMPATIBILITY IDEOGRAPH-FAB3
+	0xFAB4:  0x83EF,  // CJK COMPATIBILITY IDEOGRAPH-FAB4
+	0xFAB5:  0x8779,  // CJK COMPATIBILITY IDEOGRAPH-FAB5
+	0xFAB6:  0x8941,  // CJK COMPATIBILITY IDEOGRAPH-FAB6
+	0xFAB7:  0x8986,  // CJK COMPATIBILITY IDEOGRAPH-FAB7
+	0xFAB8:  0x8996,  // CJK COMPATIBILITY IDEOGRAPH-FAB8
+	0xFAB9:  0x8ABF,  // CJK COMPATIBILITY IDEOGRAPH-FAB9
+	0xFABA:  0x8AF8,  // CJK COMPATIBILITY IDEOGRAPH-FABA
+	0xFABB:  0x8ACB,  // CJK COMPATIBILITY IDEOGRAPH-FABB
+	0xFABC:  0x8B01,  // CJK COMPATIBILITY IDEOGRAPH-FABC
+	0xFABD:  0x8AFE,  // CJK COMPATIBILITY IDEOGRAPH-FABD
+	0xFABE:  0x8AED,  // CJK COMPATIBILITY IDEOGRAPH-FABE
+	0xFABF:  0x8B39,  // CJK COMPATIBILITY IDEOGRAPH-FABF
+	0xFAC0:  0x8B8A,  // CJK COMPATIBILITY IDEOGRAPH-FAC0
+	0xFAC1:  0x8D08,  // CJK COMPATIBILITY IDEOGRAPH-FAC1
+	0xFAC2:  0x8F38,  // CJK COMPATIBILITY IDEOGRAPH-FAC2
+	0xFAC3:  0x9072,  // CJK COMPATIBILITY IDEOGRAPH-FAC3
+	0xFAC4:  0x9199,  // CJK COMPATIBILITY IDEOGRAPH-FAC4
+	0xFAC5:  0x9276,  // CJK COMPATIBILITY IDEOGRAPH-FAC5
+	0xFAC6:  0x967C,  // CJK COMPATIBILITY IDEOGRAPH-FAC6
+	0xFAC7:  0x96E3,  // CJK COMPATIBILITY IDEOGRAPH-FAC7
+	0xFAC8:  0x9756,  // CJK COMPATIBILITY IDEOGRAPH-FAC8
+	0xFAC9:  0x97DB,  // CJK COMPATIBILITY IDEOGRAPH-FAC9
+	0xFACA:  0x97FF,  // CJK COMPATIBILITY IDEOGRAPH-FACA
+	0xFACB:  0x980B,  // CJK COMPATIBILITY IDEOGRAPH-FACB
+	0xFACC:  0x983B,  // CJK COMPATIBILITY IDEOGRAPH-FACC
+	0xFACD:  0x9B12,  // CJK COMPATIBILITY IDEOGRAPH-FACD
+	0xFACE:  0x9F9C,  // CJK COMPATIBILITY IDEOGRAPH-FACE
+	0xFACF:  0x2284A, // CJK COMPATIBILITY IDEOGRAPH-FACF
+	0xFAD0:  0x22844, // CJK COMPATIBILITY IDEOGRAPH-FAD0
+	0xFAD1:  0x233D5, // CJK COMPATIBILITY IDEOGRAPH-FAD1
+	0xFAD2:  0x3B9D,  // CJK COMPATIBILITY IDEOGRAPH-FAD2
+	0xFAD3:  0x4018,  // CJK COMPATIBILITY IDEOGRAPH-FAD3
+	0xFAD4:  0x4039,  // CJK COMPATIBILITY IDEOGRAPH-FAD4
+	0xFAD5:  0x25249, // CJK COMPATIBILITY IDEOGRAPH-FAD5
+	0xFAD6:  0x25CD0, // CJK COMPATIBILITY IDEOGRAPH-FAD6
+	0xFAD7:  0x27ED3, // CJK COMPATIBILITY IDEOGRAPH-FAD7
+	0xFAD8:  0x9F43,  // CJK COMPATIBILITY IDEOGRAPH-FAD8
+	0xFAD9:  0x9F8E,  // CJK COMPATIBILITY IDEOGRAPH-FAD9
+	0xFB1D:  0x05D9,  // HEBREW LETTER YOD WITH HIRIQ
+	0xFB1F:  0x05F2,  // HEBREW LIGATURE YIDDISH YOD YOD PATAH
+	0xFB2A:  0x05E9,  // HEBREW LETTER SHIN WITH SHIN DOT
+	0xFB2B:  0x05E9,  // HEBREW LETTER SHIN WITH SIN DOT
+	0xFB2C:  0x05E9,  // HEBREW LETTER SHIN WITH DAGESH AND SHIN DOT
+	0xFB2D:  0x05E9,  // HEBREW LETTER SHIN WITH DAGESH AND SIN DOT
+	0xFB2E:  0x05D0,  // HEBREW LETTER ALEF WITH PATAH
+	0xFB2F:  0x05D0,  // HEBREW LETTER ALEF WITH QAMATS
+	0xFB30:  0x05D0,  // HEBREW LETTER ALEF WITH MAPIQ
+	0xFB31:  0x05D1,  // HEBREW LETTER BET WITH DAGESH
+	0xFB32:  0x05D2,  // HEBREW LETTER GIMEL WITH DAGESH
+	0xFB33:  0x05D3,  // HEBREW LETTER DALET WITH DAGESH
+	0xFB34:  0x05D4,  // HEBREW LETTER HE WITH MAPIQ
+	0xFB35:  0x05D5,  // HEBREW LETTER VAV WITH DAGESH
+	0xFB36:  0x05D6,  // HEBREW LETTER ZAYIN WITH DAGESH
+	0xFB38:  0x05D8,  // HEBREW LETTER TET WITH DAGESH
+	0xFB39:  0x05D9,  // HEBREW LETTER YOD WITH DAGESH
+	0xFB3A:  0x05DA,  // HEBREW LETTER FINAL KAF WITH DAGESH
+	0xFB3B:  0x05DB,  // HEBREW LETTER KAF WITH DAGESH
+	0xFB3C:  0x05DC,  // HEBREW LETTER LAMED WITH DAGESH
+	0xFB3E:  0x05DE,  // HEBREW LETTER MEM WITH DAGESH
+	0xFB40:  0x05E0,  // HEBREW LETTER NUN WITH DAGESH
+	0xFB41:  0x05E1,  // HEBREW LETTER SAMEKH WITH DAGESH
+	0xFB43:  0x05E3,  // HEBREW LETTER FINAL PE WITH DAGESH
+	0xFB44:  0x05E4,  // HEBREW LETTER PE WITH DAGESH
+	0xFB46:  0x05E6,  // HEBREW LETTER TSADI WITH DAGESH
+	0xFB47:  0x05E7,  // HEBREW LETTER QOF WITH DAGESH
+	0xFB48:  0x05E8,  // HEBREW LETTER RESH WITH DAGESH
+	0xFB49:  0x05E9,  // HEBREW LETTER SHIN WITH DAGESH
+	0xFB4A:  0x05EA,  // HEBREW LETTER TAV WITH DAGESH
+	0xFB4B:  0x05D5,  // HEBREW LETTER VAV WITH HOLAM
+	0xFB4C:  0x05D1,  // HEBREW LETTER BET WITH RAFE
+	0xFB4D:  0x05DB,  // HEBREW LETTER KAF WITH RAFE
+	0xFB4E:  0x05E4,  // HEBREW LETTER PE WITH RAFE
+	0x1109A: 0x11099, // KAITHI LETTER DDDHA
+	0x1109C: 0x1109B, // KAITHI LETTER RHA
+	0x110AB: 0x110A5, // KAITHI LETTER VA
+	0x114BB: 0x114B9, // TIRHUTA VOWEL SIGN AI
+	0x2F800: 0x4E3D,  // CJK COMPATIBILITY IDEOGRAPH-2F800
+	0x2F801: 0x4E38,  // CJK COMPATIBILITY IDEOGRAPH-2F801
+	0x2F802: 0x4E41,  // CJK COMPATIBILITY IDEOGRAPH-2F802
+	0x2F803: 0x20122, // CJK COMPATIBILITY IDEOGRAPH-2F803
+	0x2F804: 0x4F60,  // CJK COMPATIBILITY IDEOGRAPH-2F804
+	0x2F805: 0x4FAE,  // CJK COMPATIBILITY IDEOGRAPH-2F805
+	0x2F806: 0x4FBB,  // CJK COMPATIBILITY IDEOGRAPH-2F806
+	0x2F807: 0x5002,  // CJK COMPATIBILITY IDEOGRAPH-2F807
+	0x2F808: 0x507A,  // CJK COMPATIBILITY IDEOGRAPH-2F808
+	0x2F809: 0x5099,  // CJK COMPATIBILITY IDEOGRAPH-2F809
+	0x2F80A: 0x50E7,  // CJK COMPATIBILITY IDEOGRAPH-2F80A
+	0x2F80B: 0x50CF,  // CJK COMPATIBILITY IDEOGRAPH-2F80B
+	0x2F80C: 0x349E,  // CJK COMPATIBILITY IDEOGRAPH-2F80C
+	0x2F80D: 0x2063A, // CJK COMPATIBILITY IDEOGRAPH-2F80D
+	0x2F80E: 0x514D,  // CJK COMPATIBILITY IDEOGRAPH-2F80E
+	0x2F80F: 0x5154,  // CJK COMPATIBILITY IDEOGRAPH-2F80F
+	0x2F810: 0x5164,  // CJK COMPATIBILITY IDEOGRAPH-2F810
+	0x2F811: 0x5177,  // CJK COMPATIBILITY IDEOGRAPH-2F811
+	0x2F812: 0x2051C, // CJK COMPATIBILITY IDEOGRAPH-2F812
+	0x2F813: 0x34B9,  // CJK COMPATIBILITY IDEOGRAPH-2F813
+	0x2F814: 0x5167,  // CJK COMPATIBILITY IDEOGRAPH-2F814
+	0x2F815: 0x518D,  // CJK COMPATIBILITY IDEOGRAPH-2F815
+	0x2F816: 0x2054B, // CJK COMPATIBILITY IDEOGRAPH-2F816
+	0x2F817: 0x5197,  // CJK COMPATIBILITY IDEOGRAPH-2F817
+	0x2F818: 0x51A4,  // CJK COMPATIBILITY IDEOGRAPH-2F818
+	0x2F819: 0x4ECC,  // CJK COMPATIBILITY IDEOGRAPH-2F819
+	0x2F81A: 0x51AC,  // CJK COMPATIBILITY IDEOGRAPH-2F81A
+	0x2F81B: 0x51B5,  // CJK COMPATIBILITY IDEOGRAPH-2F81B
+	0x2F81C: 0x291DF, // CJK COMPATIBILITY IDEOGRAPH-2F81C
+	0x2F81D: 0x51F5,  // CJK COMPATIBILITY IDEOGRAPH-2F81D
+	0x2F81E: 0x5203,  // CJK COMPATIBILITY IDEOGRAPH-2F81E
+	0x2F81F: 0x34DF,  // CJK COMPATIBILITY IDEOGRAPH-2F81F
+	0x2F820: 0x523B,  // CJK COMPATIBILITY IDEOGRAPH-2F820
+	0x2F821: 0x5246,  // CJK COMPATIBILITY IDEOGRAPH-2F821
+	0x2F822: 0x5272,  // CJK COMPATIBILITY IDEOGRAPH-2F822
+	0x2F823: 0x5277,  // CJK COMPATIBILITY IDEOGRAPH-2F823
+	0x2F824: 0x3515,  // CJK COMPATIBILITY IDEOGRAPH-2F824
+	0x2F825: 0x52C7,  // CJK COMPATIBILITY IDEOGRAPH-2F825
+	0x2F826: 0x52C9,  // CJK COMPATIBILITY IDEOGRAPH-2F826
+	0x2F827: 0x52E4,  // CJK COMPATIBILITY IDEOGRAPH-2F827
+	0x2F828: 0x52FA,  // CJK COMPATIBILITY IDEOGRAPH-2F828
+	0x2F829: 0x5305,  // CJK COMPATIBILITY IDEOGRAPH-2F829
+	0x2F82A: 0x5306,  // CJK COMPATIBILITY IDEOGRAPH-2F82A
+	0x2F82B: 0x5317,  // CJK COMPATIBILITY IDEOGRAPH-2F82B
+	0x2F82C: 0x5349,  // CJK COMPATIBILITY IDEOGRAPH-2F82C
+	0x2F82D: 0x5351,  // CJK COMPATIBILITY IDEOGRAPH-2F82D
+	0x2F82E: 0x535A,  // CJK COMPATIBILITY IDEOGRAPH-2F82E
+	0x2F82F: 0x5373,  // CJK COMPATIBILITY IDEOGRAPH-2F82F
+	0x2F830: 0x537D,  // CJK COMPATIBILITY IDEOGRAPH-2F830
+	0x2F831: 0x537F,  // CJK COMPATIBILITY IDEOGRAPH-2F831
+	0x2F832: 0x537F,  // CJK COMPATIBILITY IDEOGRAPH-2F832
+	0x2F833: 0x537F,  // CJK COMPATIBILITY IDEOGRAPH-2F833
+	0x2F834: 0x20A2C, // CJK COMPATIBILITY IDEOGRAPH-2F834
+	0x2F835: 0x7070,  // CJK COMPATIBILITY IDEOGRAPH-2F835
+	0x2F836: 0x53CA,  // CJK COMPATIBILITY IDEOGRAPH-2F836
+	0x2F837: 0x53DF,  // CJK COMPATIBILITY IDEOGRAPH-2F837
+	0x2F838: 0x20B63, // CJK COMPATIBILITY IDEOGRAPH-2F838
+	0x2F839: 0x53EB,  // CJK COMPATIBILITY IDEOGRAPH-2F839
+	0x2F83A: 0x53F1,  // CJK COMPATIBILITY IDEOGRAPH-2F83A
+	0x2F83B: 0x5406,  // CJK COMPATIBILITY IDEOGRAPH-2F83B
+	0x2F83C: 0x549E,  // CJK COMPATIBILITY IDEOGRAPH-2F83C
+	0x2F83D: 0x5438,  // CJK COMPATIBILITY IDEOGRAPH-2F83D
+	0x2F83E: 0x5448,  // CJK COMPATIBILITY IDEOGRAPH-2F83E
+	0x2F83F: 0x5468,  // CJK COMPATIBILITY IDEOGRAPH-2F83F
+	0x2F840: 0x54A2,  // CJK COMPATIBILITY IDEOGRAPH-2F840
+	0x2F841: 0x54F6,  // CJK COMPATIBILITY IDEOGRAPH-2F841
+	0x2F842: 0x5510,  // CJK COMPATIBILITY IDEOGRAPH-2F842
+	0x2F843: 0x5553,  // CJK COMPATIBILITY IDEOGRAPH-2F843
+	0x2F844: 0x5563,  // CJK COMPATIBILITY IDEOGRAPH-2F844
+	0x2F845: 0x5584,  // CJK COMPATIBILITY IDEOGRAPH-2F845
+	0x2F846: 0x5584,  // CJK COMPATIBILITY IDEOGRAPH-2F846
+	0x2F847: 0x5599,  // CJK COMPATIBILITY IDEOGRAPH-2F847
+	0x2F848: 0x55AB,  // CJK COMPATIBILITY IDEOGRAPH-2F848
+	0x2F849: 0x55B3,  // CJK COMPATIBILITY IDEOGRAPH-2F849
+	0x2F84A: 0x55C2,  // CJK COMPATIBILITY IDEOGRAPH-2F84A
+	0x2F84B: 0x5716,  // CJK COMPATIBILITY IDEOGRAPH-2F84B
+	0x2F84C: 0x5606,  // CJK COMPATIBILITY IDEOGRAPH-2F84C
+	0x2F84D: 0x5717,  // CJK COMPATIBILITY IDEOGRAPH-2F84D
+	0x2F84E: 0x5651,  // CJK COMPATIBILITY IDEOGRAPH-2F84E
+	0x2F84F: 0x5674,  // CJK COMPATIBILITY IDEOGRAPH-2F84F
+	0x2F850: 0x5207,  // CJK COMPATIBILITY IDEOGRAPH-2F850
+	0x2F851: 0x58EE,  // CJK COMPATIBILITY IDEOGRAPH-2F851
+	0x2F852: 0x57CE,  // CJK COMPATIBILITY IDEOGRAPH-2F852
+	0x2F853: 0x57F4,  // CJK COMPATIBILITY IDEOGRAPH-2F853
+	0x2F854: 0x580D,  // CJK COMPATIBILITY IDEOGRAPH-2F854
+	0x2F855: 0x578B,  // CJK COMPATIBILITY IDEOGRAPH-2F855
+	0x2F856: 0x5832,  // CJK COMPATIBILITY IDEOGRAPH-2F856
+	0x2F857: 0x5831,  // CJK COMPATIBILITY IDEOGRAPH-2F857
+	0x2F858: 0x58AC,  // CJK COMPATIBILITY IDEOGRAPH-2F858
+	0x2F859: 0x214E4, // CJK COMPATIBILITY IDEOGRAPH-2F859
+	0x2F85A: 0x58F2,  // CJK COMPATIBILITY IDEOGRAPH-2F85A
+	0x2F85B: 0x58F7,  // CJK COMPATIBILITY IDEOGRAPH-2F85B
+	0x2F85C: 0x5906,  // CJK COMPATIBILITY IDEOGRAPH-2F85C
+	0x2F85D: 0x591A,  // CJK COMPATIBILITY IDEOGRAPH-2F85D
+	0x2F85E: 0x5922,  // CJK COMPATIBILITY IDEOGRAPH-2F85E
+	0x2F85F: 0x5962,  // CJK COMPATIBILITY IDEOGRAPH-2F85F
+	0x2F860: 0x216A8, // CJK COMPATIBILITY IDEOGRAPH-2F860
+	0x2F861: 0x216EA, // CJK COMPATIBILITY IDEOGRAPH-2F861
+	0x2F862: 0x59EC,  // CJK COMPATIBILITY IDEOGRAPH-2F862
+	0x2F863: 0x5A1B,  // CJK COMPATIBILITY IDEOGRAPH-2F863
+	0x2F864: 0x5A27,  // CJK COMPATIBILITY IDEOGRAPH-2F864
+	0x2F865: 0x59D8,  // CJK COMPATIBILITY IDEOGRAPH-2F865
+	0x2F866: 0x5A66,  // CJK COMPATIBILITY IDEOGRAPH-2F866
+	0x2F867: 0x36EE,  // CJK COMPATIBILITY IDEOGRAPH-2F867
+	0x2F868: 0x36FC,  // CJK COMPATIBILITY IDEOGRAPH-2F868
+	0x2F869: 0x5B08,  // CJK COMPATIBILITY IDEOGRAPH-2F869
+	0x2F86A: 0x5B3E,  // CJK COMPATIBILITY IDEOGRAPH-2F86A
+	0x2F86B: 0x5B3E,  // CJK COMPATIBILITY IDEOGRAPH-2F86B
+	0x2F86C: 0x219C8, // CJK COMPATIBILITY IDEOGRAPH-2F86C
+	0x2F86D: 0x5BC3,  // CJK COMPATIBILITY IDEOGRAPH-2F86D
+	0x2F86E: 0x5BD8,  // CJK COMPATIBILITY IDEOGRAPH-2F86E
+	0x2F86F: 0x5BE7,  // CJK COMPATIBILITY IDEOGRAPH-2F86F
+	0x2F870: 0x5BF3,  // CJK COMPATIBILITY IDEOGRAPH-2F870
+	0x2F871: 0x21B18, // CJK COMPATIBILITY IDEOGRAPH-2F871
+	0x2F872: 0x5BFF,  // CJK COMPATIBILITY IDEOGRAPH-2F872
+	0x2F873: 0x5C06,  // CJK COMPATIBILITY IDEOGRAPH-2F873
+	0x2F874: 0x5F53,  // CJK COMPATIBILITY IDEOGRAPH-2F874
+	0x2F875: 0x5C22,  // CJK COMPATIBILITY IDEOGRAPH-2F875
+	0x2F876: 0x3781,  // CJK COMPATIBILITY IDEOGRAPH-2F876
+	0x2F877: 0x5C60,  // CJK COMPATIBILITY IDEOGRAPH-2F877
+	0x2F878: 0x5C6E,  // CJK COMPATIBILITY IDEOGRAPH-2F878
+	0x2F879: 0x5CC0,  // CJK COMPATIBILITY IDEOGRAPH-2F879
+	0x2F87A: 0x5C8D,  // CJK COMPATIBILITY IDEOGRAPH-2F87A
+	0x2F87B: 0x21DE4, // CJK COMPATIBILITY IDEOGRAPH-2F87B
+	0x2F87C: 0x5D43,  // CJK COMPATIBILITY IDEOGRAPH-2F87C
+	0x2F87D: 0x21DE6, // CJK COMPATIBILITY IDEOGRAPH-2F87D
+	0x2F87E: 0x5D6E,  // CJK COMPATIBILITY IDEOGRAPH-2F87E
+	0x2F87F: 0x5D6B,  // CJK COMPATIBILITY IDEOGRAPH-2F87F
+	0x2F880: 0x5D7C,  // CJK COMPATIBILITY IDEOGRAPH-2F880
+	0x2F881: 0x5DE1,  // CJK COMPATIBILITY IDEOGRAPH-2F881
+	0x2F882: 0x5DE2,  // CJK COMPATIBILITY IDEOGRAPH-2F882
+	0x2F883: 0x382F,  // CJK COMPATIBILITY IDEOGRAPH-2F883
+	0x2F884: 0x5DFD,  // CJK COMPATIBILITY IDEOGRAPH-2F884
+	0x2F885: 0x5E28,  // CJK COMPATIBILITY IDEOGRAPH-2F885
+	0x2F886: 0x5E3D,  // CJK COMPATIBILITY IDEOGRAPH-2F886
+	0x2F887: 0x5E69,  // CJK COMPATIBILITY IDEOGRAPH-2F887
+	0x2F888: 0x3862,  // CJK COMPATIBILITY IDEOGRAPH-2F888
+	0x2F889: 0x22183, // CJK COMPATIBILITY IDEOGRAPH-2F889
+	0x2F88A: 0x387C,  // CJK COMPATIBILITY IDEOGRAPH-2F88A
+	0x2F88B: 0x5EB0,  // CJK COMPATIBILITY IDEOGRAPH-2F88B
+	0x2F88C: 0x5EB3,  // CJK COMPATIBILITY IDEOGRAPH-2F88C
+	0x2F88D: 0x5EB6,  // CJK COMPATIBILITY IDEOGRAPH-2F88D
+	0x2F88E: 0x5ECA,  // CJK COMPATIBILITY IDEOGRAPH-2F88E
+	0x2F88F: 0x2A392, // CJK COMPATIBILITY IDEOGRAPH-2F88F
+	0x2F890: 0x5EFE,  // CJK COMPATIBILITY IDEOGRAPH-2F890
+	0x2F891: 0x22331, // CJK COMPATIBILITY IDEOGRAPH-2F891
+	0x2F892: 0x22331, // CJK COMPATIBILITY IDEOGRAPH-2F892
+	0x2F893: 0x8201,  // CJK COMPATIBILITY IDEOGRAPH-2F893
+	0x2F894: 0x5F22,  // CJK COMPATIBILITY IDEOGRAPH-2F894
+	0x2F895: 0x5F22,  // CJK COMPATIBILITY IDEOGRAPH-2F895
+	0x2F896: 0x38C7,  // CJK COMPATIBILITY IDEOGRAPH-2F896
+	0x2F897: 0x232B8, // CJK COMPATIBILITY IDEOGRAPH-2F897
+	0x2F898: 0x261DA, // CJK COMPATIBILITY IDEOGRAPH-2F898
+	0x2F899: 0x5F62,  // CJK COMPATIBILITY IDEOGRAPH-2F899
+	0x2F89A: 0x5F6B,  // CJK COMPATIBILITY IDEOGRAPH-2F89A
+	0x2F89B: 0x38E3,  // CJK COMPATIBILITY IDEOGRAPH-2F89B
+	0x2F89C: 0x5F9A,  // CJK COMPATIBILITY IDEOGRAPH-2F89C
+	0x2F89D: 0x5FCD,  // CJK COMPATIBILITY IDEOGRAPH-2F89D
+	0x2F89E: 0x5FD7,  // CJK COMPATIBILITY IDEOGRAPH-2F89E
+	0x2F89F: 0x5FF9,  // CJK COMPATIBILITY IDEOGRAPH-2F89F
+	0x2F8A0: 0x6081,  // CJK COMPATIBILITY IDEOGRAPH-2F8A0
+	0x2F8A1: 0x393A,  // CJK COMPATIBILITY IDEOGRAPH-2F8A1
+	0x2F8A2: 0x391C,  // CJK COMPATIBILITY IDEOGRAPH-2F8A2
+	0x2F8A3: 0x6094,  // CJK COMPATIBILITY IDEOGRAPH-2F8A3
+	0x2F8A4: 0x226D4, // CJK COMPATIBILITY IDEOGRAPH-2F8A4
+	0x2F8A5: 0x60C7,  // CJK COMPATIBILITY IDEOGRAPH-2F8A5
+	0x2F8A6: 0x6148,  // CJK COMPATIBILITY IDEOGRAPH-2F8A6
+	0x2F8A7: 0x614C,  // CJK COMPATIBILITY IDEOGRAPH-2F8A7
+	0x2F8A8: 0x614E,  // CJK COMPATIBILITY IDEOGRAPH-2F8A8
+	0x2F8A9: 0x614C,  // CJK COMPATIBILITY IDEOGRAPH-2F8A9
+	0x2F8AA: 0x617A,  // CJK COMPATIBILITY IDEOGRAPH-2F8AA
+	0x2F8AB: 0x618E,  // CJK COMPATIBILITY IDEOGRAPH-2F8AB
+	0x2F8AC: 0x61B2,  // CJK COMPATIBILITY IDEOGRAPH-2F8AC
+	0x2F8AD: 0x61A4,  // CJK COMPATIBILITY IDEOGRAPH-2F8AD
+	0x2F8AE: 0x61AF,  // CJK COMPATIBILITY IDEOGRAPH-2F8AE
+	0x2F8AF: 0x61DE,  // CJK COMPATIBILITY IDEOGRAPH-2F8AF
+	0x2F8B0: 0x61F2,  // CJK COMPATIBILITY IDEOGRAPH-2F8B0
+	0x2F8B1: 0x61F6,  // CJK COMPATIBILITY IDEOGRAPH-2F8B1
+	0x2F8B2: 0x6210,  // CJK COMPATIBILITY IDEOGRAPH-2F8B2
+	0x2F8B3: 0x621B,  // CJK COMPATIBILITY IDEOGRAPH-2F8B3
+	0x2F8B4: 0x625D,  // CJK COMPATIBILITY IDEOGRAPH-2F8B4
+	0x2F8B5: 0x62B1,  // CJK COMPATIBILITY IDEOGRAPH-2F8B5
+	0x2F8B6: 0x62D4,  // CJK COMPATIBILITY IDEOGRAPH-2F8B6
+	0x2F8B7: 0x6350,  // CJK COMPATIBILITY IDEOGRAPH-2F8B7
+	0x2F8B8: 0x22B0C, // CJK COMPATIBILITY IDEOGRAPH-2F8B8
+	0x2F8B9: 0x633D,  // CJK COMPATIBILITY IDEOGRAPH-2F8B9
+	0x2F8BA: 0x62FC,  // CJK COMPATIBILITY IDEOGRAPH-2F8BA
+	0x2F8BB: 0x6368,  // CJK COMPATIBILITY IDEOGRAPH-2F8BB
+	0x2F8BC: 0x6383,  // CJK COMPATIBILITY IDEOGRAPH-2F8BC
+	0x2F8BD: 0x63E4,  // CJK COMPATIBILITY IDEOGRAPH-2F8BD
+	0x2F8BE: 0x22BF1, // CJK COMPATIBILITY IDEOGRAPH-2F8BE
+	0x2F8BF: 0x6422,  // CJK COMPATIBILITY IDEOGRAPH-2F8BF
+	0x2F8C0: 0x63C5,  // CJK COMPATIBILITY IDEOGRAPH-2F8C0
+	0x2F8C1: 0x63A9,  // CJK COMPATIBILITY IDEOGRAPH-2F8C1
+	0x2F8C2: 0x3A2E,  // CJK COMPATIBILITY IDEOGRAPH-2F8C2
+	0x2F8C3: 0x6469,  // CJK COMPATIBILITY IDEOGRAPH-2F8C3
+	0x2F8C4: 0x647E,  // CJK COMPATIBILITY IDEOGRAPH-2F8C4
+	0x2F8C5: 0x649D,  // CJK COMPATIBILITY IDEOGRAPH-2F8C5
+	0x2F8C6: 0x6477,  // CJK COMPATIBILITY IDEOGRAPH-2F8C6
+	0x2F8C7: 0x3A6C,  // CJK COMPATIBILITY IDEOGRAPH-2F8C7
+	0x2F8C8: 0x654F,  // CJK COMPATIBILITY IDEOGRAPH-2F8C8
+	0x2F8C9: 0x656C,  // CJK COMPATIBILITY IDEOGRAPH-2F8C9
+	0x2F8CA: 0x2300A, // CJK COMPATIBILITY IDEOGRAPH-2F8CA
+	0x2F8CB: 0x65E3,  // CJK COMPATIBILITY IDEOGRAPH-2F8CB
+	0x2F8CC: 0x66F8,  // CJK COMPATIBILITY IDEOGRAPH-2F8CC
+	0x2F8CD: 0x6649,  // CJK COMPATIBILITY IDEOGRAPH-2F8CD
+	0x2F8CE: 0x3B19,  // CJK COMPATIBILITY IDEOGRAPH-2F8CE
+	0x2F8CF: 0x6691,  // CJK COMPATIBILITY IDEOGRAPH-2F8CF
+	0x2F8D0: 0x3B08,  // CJK COMPATIBILITY IDEOGRAPH-2F8D0
+	0x2F8D1: 0x3AE4,  // CJK COMPATIBILITY IDEOGRAPH-2F8D1
+	0x2F8D2: 0x5192,  // CJK COMPATIBILITY IDEOGRAPH-2F8D2
+	0x2F8D3: 0x5195,  // CJK COMPATIBILITY IDEOGRAPH-2F8D3
+	0x2F8D4: 0x6700,  // CJK COMPATIBILITY IDEOGRAPH-2F8D4
+	0x2F8D5: 0x669C,  // CJK COMPATIBILITY IDEOGRAPH-2F8D5
+	0x2F8D6: 0x80AD,  // CJK COMPATIBILITY IDEOGRAPH-2F8D6
+	0x2F8D7: 0x43D9,  // CJK COMPATIBILITY IDEOGRAPH-2F8D7
+	0x2F8D8: 0x6717,  // CJK COMPATIBILITY IDEOGRAPH-2F8D8
+	0x2F8D9: 0x671B,  // CJK COMPATIBILITY IDEOGRAPH-2F8D9
+	0x2F8DA: 0x6721,  // CJK COMPATIBILITY IDEOGRAPH-2F8DA
+	0x2F8DB: 0x675E,  // CJK COMPATIBILITY IDEOGRAPH-2F8DB
+	0x2F8DC: 0x6753,  // CJK COMPATIBILITY IDEOGRAPH-2F8DC
+	0x2F8DD: 0x233C3, // CJK COMPATIBILITY IDEOGRAPH-2F8DD
+	0x2F8DE: 0x3B49,  // CJK COMPATIBILITY IDEOGRAPH-2F8DE
+	0x2F8DF: 0x67FA,  // CJK COMPATIBILITY IDEOGRAPH-2F8DF
+	0x2F8E0: 0x6785,  // CJK COMPATIBILITY IDEOGRAPH-2F8E0
+	0x2F8E1: 0x6852,  // CJK COMPATIBILITY IDEOGRAPH-2F8E1
+	0x2F8E2: 0x6885,  // CJK COMPATIBILITY IDEOGRAPH-2F8E2
+	0x2F8E3: 0x2346D, // CJK COMPATIBILITY IDEOGRAPH-2F8E3
+	0x2F8E4: 0x688E,  // CJK COMPATIBILITY IDEOGRAPH-2F8E4
+	0x2F8E5: 0x681F,  // CJK COMPATIBILITY IDEOGRAPH-2F8E5
+	0x2F8E6: 0x6914,  // CJK COMPATIBILITY IDEOGRAPH-2F8E6
+	0x2F8E7: 0x3B9D,  // CJK COMPATIBILITY IDEOGRAPH-2F8E7
+	0x2F8E8: 0x6942,  // CJK COMPATIBILITY IDEOGRAPH-2F8E8
+	0x2F8E9: 0x69A3,  // CJK COMPATIBILITY IDEOGRAPH-2F8E9
+	0x2F8EA: 0x69EA,  // CJK COMPATIBILITY IDEOGRAPH-2F8EA
+	0x2F8EB: 0x6AA8,  // CJK COMPATIBILITY IDEOGRAPH-2F8EB
+	0x2F8EC: 0x236A3, // CJK COMPATIBILITY IDEOGRAPH-2F8EC
+	0x2F8ED: 0x6ADB,  // CJK COMPATIBILITY IDEOGRAPH-2F8ED
+	0x2F8EE: 0x3C18,  // CJK COMPATIBILITY IDEOGRAPH-2F8EE
+	0x2F8EF: 0x6B21,  // CJK COMPATIBILITY IDEOGRAPH-2F8EF
+	0x2F8F0: 0x238A7, // CJK COMPATIBILITY IDEOGRAPH-2F8F0
+	0x2F8F1: 0x6B54,  // CJK COMPATIBILITY IDEOGRAPH-2F8F1
+	0x2F8F2: 0x3C4E,  // CJK COMPATIBILITY IDEOGRAPH-2F8F2
+	0x2F8F3: 0x6B72,  // CJK COMPATIBILITY IDEOGRAPH-2F8F3
+	0x2F8F4: 0x6B9F,  // CJK COMPATIBILITY IDEOGRAPH-2F8F4
+	0x2F8F5: 0x6BBA,  // CJK COMPATIBILITY IDEOGRAPH-2F8F5
+	0x2F8F6: 0x6BBB,  // CJK COMPATIBILITY IDEOGRAPH-2F8F6
+	0x2F8F7: 0x23A8D, // CJK COMPATIBILITY IDEOGRAPH-2F8F7
+	0x2F8F8: 0x21D0B, // CJK COMPATIBILITY IDEOGRAPH-2F8F8
+	0x2F8F9: 0x23AFA, // CJK COMPATIBILITY IDEOGRAPH-2F8F9
+	0x2F8FA: 0x6C4E,  // CJK COMPATIBILITY IDEOGRAPH-2F8FA
+	0x2F8FB: 0x23CBC, // CJK COMPATIBILITY IDEOGRAPH-2F8FB
+	0x2F8FC: 0x6CBF,  // CJK COMPATIBILITY IDEOGRAPH-2F8FC
+	0x2F8FD: 0x6CCD,  // CJK COMPATIBILITY IDEOGRAPH-2F8FD
+	0x2F8FE: 0x6C67,  // CJK COMPATIBILITY IDEOGRAPH-2F8FE
+	0x2F8FF: 0x6D16,  // CJK COMPATIBILITY IDEOGRAPH-2F8FF
+	0x2F900: 0x6D3E,  // CJK COMPATIBILITY IDEOGRAPH-2F900
+	0x2F901: 0x6D77,  // CJK COMPATIBILITY IDEOGRAPH-2F901
+	0x2F902: 0x6D41,  // CJK COMPATIBILITY IDEOGRAPH-2F902
+	0x2F903: 0x6D69,  // CJK COMPATIBILITY IDEOGRAPH-2F903
+	0x2F904: 0x6D78,  // CJK COMPATIBILITY IDEOGRAPH-2F904
+	0x2F905: 0x6D85,  // CJK COMPATIBILITY IDEOGRAPH-2F905
+	0x2F906: 0x23D1E, // CJK COMPATIBILITY IDEOGRAPH-2F906
+	0x2F907: 0x6D34,  // CJK COMPATIBILITY IDEOGRAPH-2F907
+	0x2F908: 0x6E2F,  // CJK COMPATIBILITY IDEOGRAPH-2F908
+	0x2F909: 0x6E6E,  // CJK COMPATIBILITY IDEOGRAPH-2F909
+	0x2F90A: 0x3D33,  // CJK COMPATIBILITY IDEOGRAPH-2F90A
+	0x2F90B: 0x6ECB,  // CJK COMPATIBILITY IDEOGRAPH-2F90B
+	0x2F90C: 0x6EC7,  // CJK COMPATIBILITY IDEOGRAPH-2F90C
+	0x2F90D: 0x23ED1, // CJK COMPATIBILITY IDEOGRAPH-2F90D
+	0x2F90E: 0x6DF9,  // CJK COMPATIBILITY IDEOGRAPH-2F90E
+	0x2F90F: 0x6F6E,  // CJK COMPATIBILITY IDEOGRAPH-2F90F
+	0x2F910: 0x23F5E, // CJK COMPATIBILITY IDEOGRAPH-2F910
+	0x2F911: 0x23F8E, // CJK COMPATIBILITY IDEOGRAPH-2F911
+	0x2F912: 0x6FC6,  // CJK COMPATIBILITY IDEOGRAPH-2F912
+	0x2F913: 0x7039,  // CJK COMPATIBILITY IDEOGRAPH-2F913
+	0x2F914: 0x701E,  // CJK COMPATIBILITY IDEOGRAPH-2F914
+	0x2F915: 0x701B,  // CJK COMPATIBILITY IDEOGRAPH-2F915
+	0x2F916: 0x3D96,  // CJK COMPATIBILITY IDEOGRAPH-2F916
+	0x2F917: 0x704A,  // CJK COMPATIBILITY IDEOGRAPH-2F917
+	0x2F918: 0x707D,  // CJK COMPATIBILITY IDEOGRAPH-2F918
+	0x2F919: 0x7077,  // CJK COMPATIBILITY IDEOGRAPH-2F919
+	0x2F91A: 0x70AD,  // CJK COMPATIBILITY IDEOGRAPH-2F91A
+	0x2F91B: 0x20525, // CJK COMPATIBILITY IDEOGRAPH-2F91B
+	0x2F91C: 0x7145,  // CJK COMPATIBILITY IDEOGRAPH-2F91C
+	0x2F91D: 0x24263, // CJK COMPATIBILITY IDEOGRAPH-2F91D
+	0x2F91E: 0x719C,  // CJK COMPATIBILITY IDEOGRAPH-2F91E
+	0x2F91F: 0x243AB, // CJK COMPATIBILITY IDEOGRAPH-2F91F
+	0x2F920: 0x7228,  // CJK COMPATIBILITY IDEOGRAPH-2F920
+	0x2F921: 0x7235,  // CJK COMPATIBILITY IDEOGRAPH-2F921
+	0x2F922: 0x7250,  // CJK COMPATIBILITY IDEOGRAPH-2F922
+	0x2F923: 0x24608, // CJK COMPATIBILITY IDEOGRAPH-2F923
+	0x2F924: 0x7280,  // CJK COMPATIBILITY IDEOGRAPH-2F924
+	0x2F925: 0x7295,  // CJK COMPATIBILITY IDEOGRAPH-2F925
+	0x2F926: 0x24735, // CJK COMPATIBILITY IDEOGRAPH-2F926
+	0x2F927: 0x24814, // CJK COMPATIBILITY IDEOGRAPH-2F927
+	0x2F928: 0x737A,  // CJK COMPATIBILITY IDEOGRAPH-2F928
+	0x2F929: 0x738B,  // CJK COMPATIBILITY IDEOGRAPH-2F929
+	0x2F92A: 0x3EAC,  // CJK COMPATIBILITY IDEOGRAPH-2F92A
+	0x2F92B: 0x73A5,  // CJK COMPATIBILITY IDEOGRAPH-2F92B
+	0x2F92C: 0x3EB8,  // CJK COMPATIBILITY IDEOGRAPH-2F92C
+	0x2F92D: 0x3EB8,  // CJK COMPATIBILITY IDEOGRAPH-2F92D
+	0x2F92E: 0x7447,  // CJK COMPATIBILITY IDEOGRAPH-2F92E
+	0x2F92F: 0x745C,  // CJK COMPATIBILITY IDEOGRAPH-2F92F
+	0x2F930: 0x7471,  // CJK COMPATIBILITY IDEOGRAPH-2F930
+	0x2F931: 0x7485,  // CJK COMPATIBILITY IDEOGRAPH-2F931
+	0x2F932: 0x74CA,  // CJK COMPATIBILITY IDEOGRAPH-2F932
+	0x2F933: 0x3F1B,  // CJK COMPATIBILITY IDEOGRAPH-2F933
+	0x2F934: 0x7524,  // CJK COMPATIBILITY IDEOGRAPH-2F934
+	0x2F935: 0x24C36, // CJK COMPATIBILITY IDEOGRAPH-2F935
+	0x2F936: 0x753E,  // CJK COMPATIBILITY IDEOGRAPH-2F936
+	0x2F937: 0x24C92, // CJK COMPATIBILITY IDEOGRAPH-2F937
+	0x2F938: 0x7570,  // CJK COMPATIBILITY IDEOGRAPH-2F938
+	0x2F939: 0x2219F, // CJK COMPATIBILITY IDEOGRAPH-2F939
+	0x2F93A: 0x7610,  // CJK COMPATIBILITY IDEOGRAPH-2F93A
+	0x2F93B: 0x24FA1, // CJK COMPATIBILITY IDEOGRAPH-2F93B
+	0x2F93C: 0x24FB8, // CJK COMPATIBILITY IDEOGRAPH-2F93C
+	0x2F93D: 0x25044, // CJK COMPATIBILITY IDEOGRAPH-2F93D
+	0x2F93E: 0x3FFC,  // CJK COMPATIBILITY IDEOGRAPH-2F93E
+	0x2F93F: 0x4008,  // CJK COMPATIBILITY IDEOGRAPH-2F93F
+	0x2F940: 0x76F4,  // CJK COMPATIBILITY IDEOGRAPH-2F940
+	0x2F941: 0x250F3, // CJK COMPATIBILITY IDEOGRAPH-2F941
+	0x2F942: 0x250F2, // CJK COMPATIBILITY IDEOGRAPH-2F942
+	0x2F943: 0x25119, // CJK COMPATIBILITY IDEOGRAPH-2F943
+	0x2F944: 0x25133, // CJK COMPATIBILITY IDEOGRAPH-2F944
+	0x2F945: 0x771E,  // CJK COMPATIBILITY IDEOGRAPH-2F945
+	0x2F946: 0x771F,  // CJK COMPATIBILITY IDEOGRAPH-2F946
+	0x2F947: 0x771F,  // CJK COMPATIBILITY IDEOGRAPH-2F947
+	0x2F948: 0x774A,  // CJK COMPATIBILITY IDEOGRAPH-2F948
+	0x2F949: 0x4039,  // CJK COMPATIBILITY IDEOGRAPH-2F949
+	0x2F94A: 0x778B,  // CJK COMPATIBILITY IDEOGRAPH-2F94A
+	0x2F94B: 0x4046,  // CJK COMPATIBILITY IDEOGRAPH-2F94B
+	0x2F94C: 0x4096,  // CJK COMPATIBILITY IDEOGRAPH-2F94C
+	0x2F94D: 0x2541D, // CJK COMPATIBILITY IDEOGRAPH-2F94D
+	0x2F94E: 0x784E,  // CJK COMPATIBILITY IDEOGRAPH-2F94E
+	0x2F94F: 0x788C,  // CJK COMPATIBILITY IDEOGRAPH-2F94F
+	0x2F950: 0x78CC,  // CJK COMPATIBILITY IDEOGRAPH-2F950
+	0x2F951: 0x40E3,  // CJK COMPATIBILITY IDEOGRAPH-2F951
+	0x2F952: 0x25626, // CJK COMPATIBILITY IDEOGRAPH-2F952
+	0x2F953: 0x7956,  // CJK COMPATIBILITY IDEOGRAPH-2F953
+	0x2F954: 0x2569A, // CJK COMPATIBILITY IDEOGRAPH-2F954
+	0x2F955: 0x256C5, // CJK COMPATIBILITY IDEOGRAPH-2F955
+	0x2F956: 0x798F,  // CJK COMPATIBILITY IDEOGRAPH-2F956
+	0x2F957: 0x79EB,  // CJK COMPATIBILITY IDEOGRAPH-2F957
+	0x2F958: 0x412F,  // CJK COMPATIBILITY IDEOGRAPH-2F958
+	0x2F959: 0x7A40,  // CJK COMPATIBILITY IDEOGRAPH-2F959
+	0x2F95A: 0x7A4A,  // CJK COMPATIBILITY IDEOGRAPH-2F95A
+	0x2F95B: 0x7A4F,  // CJK COMPATIBILITY IDEOGRAPH-2F95B
+	0x2F95C: 0x2597C, // CJK COMPATIBILITY IDEOGRAPH-2F95C
+	0x2F95D: 0x25AA7, // CJK COMPATIBILITY IDEOGRAPH-2F95D
+	0x2F95E: 0x25AA7, // CJK COMPATIBILITY IDEOGRAPH-2F95E
+	0x2F95F: 0x7AEE,  // CJK COMPATIBILITY IDEOGRAPH-2F95F
+	0x2F960: 0x4202,  // CJK COMPATIBILITY IDEOGRAPH-2F960
+	0x2F961: 0x25BAB, // CJK COMPATIBILITY IDEOGRAPH-2F961
+	0x2F962: 0x7BC6,  // CJK COMPATIBILITY IDEOGRAPH-2F962
+	0x2F963: 0x7BC9,  // CJK COMPATIBILITY IDEOGRAPH-2F963
+	0x2F964: 0x4227,  // CJK COMPATIBILITY IDEOGRAPH-2F964
+	0x2F965: 0x25C80, // CJK COMPATIBILITY IDEOGRAPH-2F965
+	0x2F966: 0x7CD2,  // CJK COMPATIBILITY IDEOGRAPH-2F966
+	0x2F967: 0x42A0,  // CJK COMPATIBILITY IDEOGRAPH-2F967
+	0x2F968: 0x7CE8,  // CJK COMPATIBILITY IDEOGRAPH-2F968
+	0x2F969: 0x7CE3,  // CJK COMPATIBILITY IDEOGRAPH-2F969
+	0x2F96A: 0x7D00,  // CJK COMPATIBILITY IDEOGRAPH-2F96A
+	0x2F96B: 0x25F86, // CJK COMPATIBILITY IDEOGRAPH-2F96B
+	0x2F96C: 0x7D63,  // CJK COMPATIBILITY IDEOGRAPH-2F96C
+	0x2F96D: 0x4301,  // CJK COMPATIBILITY IDEOGRAPH-2F96D
+	0x2F96E: 0x7DC7,  // CJK COMPATIBILITY IDEOGRAPH-2F96E
+	0x2F96F: 0x7E02,  // CJK COMPATIBILITY IDEOGRAPH-2F96F
+	0x2F970: 0x7E45,  // CJK COMPATIBILITY IDEOGRAPH-2F970
+	0x2F971: 0x4334,  // CJK COMPATIBILITY IDEOGRAPH-2F971
+	0x2F972: 0x26228, // CJK COMPATIBILITY IDEOGRAPH-2F972
+	0x2F973: 0x26247, // CJK COMPATIBILITY IDEOGRAPH-2F973
+	0x2F974: 0x4359,  // CJK COMPATIBILITY IDEOGRAPH-2F974
+	0x2F975: 0x262D9, // CJK COMPATIBILITY IDEOGRAPH-2F975
+	0x2F976: 0x7F7A,  // CJK COMPATIBILITY IDEOGRAPH-2F976
+	0x2F977: 0x2633E, // CJK COMPATIBILITY IDEOGRAPH-2F977
+	0x2F978: 0x7F95,  // CJK COMPATIBILITY IDEOGRAPH-2F978
+	0x2F979: 0x7FFA,  // CJK COMPATIBILITY IDEOGRAPH-2F979
+	0x2F97A: 0x8005,  // CJK COMPATIBILITY IDEOGRAPH-2F97A
+	0x2F97B: 0x264DA, // CJK COMPATIBILITY IDEOGRAPH-2F97B
+	0x2F97C: 0x26523, // CJK COMPATIBILITY IDEOGRAPH-2F97C
+	0x2F97D: 0x8060,  // CJK COMPATIBILITY IDEOGRAPH-2F97D
+	0x2F97E: 0x265A8, // CJK COMPATIBILITY IDEOGRAPH-2F97E
+	0x2F97F: 0x8070,  // CJK COMPATIBILITY IDEOGRAPH-2F97F
+	0x2F980: 0x2335F, // CJK COMPATIBILITY IDEOGRAPH-2F980
+	0x2F981: 0x43D5,  // CJK COMPATIBILITY IDEOGRAPH-2F981
+	0x2F982: 0x80B2,  // CJK COMPATIBILITY IDEOGRAPH-2F982
+	0x2F983: 0x8103,  // CJK COMPATIBILITY IDEOGRAPH-2F983
+	0x2F984: 0x440B,  // CJK COMPATIBILITY IDEOGRAPH-2F984
+	0x2F985: 0x813E,  // CJK COMPATIBILITY IDEOGRAPH-2F985
+	0x2F986: 0x5AB5,  // CJK COMPATIBILITY IDEOGRAPH-2F986
+	0x2F987: 0x267A7, // CJK COMPATIBILITY IDEOGRAPH-2F987
+	0x2F988: 0x267B5, // CJK COMPATIBILITY IDEOGRAPH-2F988
+	0x2F989: 0x23393, // CJK COMPATIBILITY IDEOGRAPH-2F989
+	0x2F98A: 0x2339C, // CJK COMPATIBILITY IDEOGRAPH-2F98A
+	0x2F98B: 0x8201,  // CJK COMPATIBILITY IDEOGRAPH-2F98B
+	0x2F98C: 0x8204,  // CJK COMPATIBILITY IDEOGRAPH-2F98C
+	0x2F98D: 0x8F9E,  // CJK COMPATIBILITY IDEOGRAPH-2F98D
+	0x2F98E: 0x446B,  // CJK COMPATIBILITY IDEOGRAPH-2F98E
+	0x2F98F: 0x8291,  // CJK COMPATIBILITY IDEOGRAPH-2F98F
+	0x2F990: 0x828B,  // CJK COMPATIBILITY IDEOGRAPH-2F990
+	0x2F991: 0x829D,  // CJK COMPATIBILITY IDEOGRAPH-2F991
+	0x2F992: 0x52B3,  // CJK COMPATIBILITY IDEOGRAPH-2F992
+	0x2F993: 0x82B1,  // CJK COMPATIBILITY IDEOGRAPH-2F993
+	0x2F994: 0x82B3,  // CJK COMPATIBILITY IDEOGRAPH-2F994
+	0x2F995: 0x82BD,  // CJK COMPATIBILITY IDEOGRAPH-2F995
+	0x2F996: 0x82E6,  // CJK COMPATIBILITY IDEOGRAPH-2F996
+	0x2F997: 0x26B3C, // CJK COMPATIBILITY IDEOGRAPH-2F997
+	0x2F998: 0x82E5,  // CJK COMPATIBILITY IDEOGRAPH-2F998
+	0x2F999: 0x831D,  // CJK COMPATIBILITY IDEOGRAPH-2F999
+	0x2F99A: 0x8363,  // CJK COMPATIBILITY IDEOGRAPH-2F99A
+	0x2F99B: 0x83AD,  // CJK COMPATIBILITY IDEOGRAPH-2F99B
+	0x2F99C: 0x8323,  // CJK COMPATIBILITY IDEOGRAPH-2F99C
+	0x2F99D: 0x83BD,  // CJK COMPATIBILITY IDEOGRAPH-2F99D
+	0x2F99E: 0x83E7,  // CJK COMPATIBILITY IDEOGRAPH-2F99E
+	0x2F99F: 0x8457,  // CJK COMPATIBILITY IDEOGRAPH-2F99F
+	0x2F9A0: 0x8353,  // CJK COMPATIBILITY IDEOGRAPH-2F9A0
+	0x2F9A1: 0x83CA,  // CJK COMPATIBILITY IDEOGRAPH-2F9A1
+	0x2F9A2: 0x83CC,  // CJK COMPATIBILITY IDEOGRAPH-2F9A2
+	0x2F9A3: 0x83DC,  // CJK COMPATIBILITY IDEOGRAPH-2F9A3
+	0x2F9A4: 0x26C36, // CJK COMPATIBILITY IDEOGRAPH-2F9A4
+	0x2F9A5: 0x26D6B, // CJK COMPATIBILITY IDEOGRAPH-2F9A5
+	0x2F9A6: 0x26CD5, // CJK COMPATIBILITY IDEOGRAPH-2F9A6
+	0x2F9A7: 0x452B,  // CJK COMPATIBILITY IDEOGRAPH-2F9A7
+	0x2F9A8: 0x84F1,  // CJK COMPATIBILITY IDEOGRAPH-2F9A8
+	0x2F9A9: 0x84F3,  // CJK COMPATIBILITY IDEOGRAPH-2F9A9
+	0x2F9AA: 0x8516,  // CJK COMPATIBILITY IDEOGRAPH-2F9AA
+	0x2F9AB: 0x273CA, // CJK COMPATIBILITY IDEOGRAPH-2F9AB
+	0x2F9AC: 0x8564,  // CJK COMPATIBILITY IDEOGRAPH-2F9AC
+	0x2F9AD: 0x26F2C, // CJK COMPATIBILITY IDEOGRAPH-2F9AD
+	0x2F9AE: 0x455D,  // CJK COMPATIBILITY IDEOGRAPH-2F9AE
+	0x2F9AF: 0x4561,  // CJK COMPATIBILITY IDEOGRAPH-2F9AF
+	0x2F9B0: 0x26FB1, // CJK COMPATIBILITY IDEOGRAPH-2F9B0
+	0x2F9B1: 0x270D2, // CJK COMPATIBILITY IDEOGRAPH-2F9B1
+	0x2F9B2: 0x456B,  // CJK COMPATIBILITY IDEOGRAPH-2F9B2
+	0x2F9B3: 0x8650,  // CJK COMPATIBILITY IDEOGRAPH-2F9B3
+	0x2F9B4: 0x865C,  // CJK COMPATIBILITY IDEOGRAPH-2F9B4
+	0x2F9B5: 0x8667,  // CJK COMPATIBILITY IDEOGRAPH-2F9B5
+	0x2F9B6: 0x8669,  // CJK COMPATIBILITY IDEOGRAPH-2F9B6
+	0x2F9B7: 0x86A9,  // CJK COMPATIBILITY IDEOGRAPH-2F9B7
+	0x2F9B8: 0x8688,  // CJK COMPATIBILITY IDEOGRAPH-2F9B8
+	0x2F9B9: 0x870E,  // CJK COMPATIBILITY IDEOGRAPH-2F9B9
+	0x2F9BA: 0x86E2,  // CJK COMPATIBILITY IDEOGRAPH-2F9BA
+	0x2F9BB: 0x8779,  // CJK COMPATIBILITY IDEOGRAPH-2F9BB
+	0x2F9BC: 0x8728,  // CJK COMPATIBILITY IDEOGRAPH-2F9BC
+	0x2F9BD: 0x876B,  // CJK COMPATIBILITY IDEOGRAPH-2F9BD
+	0x2F9BE: 0x8786,  // CJK COMPATIBILITY IDEOGRAPH-2F9BE
+	0x2F9BF: 0x45D7,  // CJK COMPATIBILITY IDEOGRAPH-2F9BF
+	0x2F9C0: 0x87E1,  // CJK COMPATIBILITY IDEOGRAPH-2F9C0
+	0x2F9C1: 0x8801,  // CJK COMPATIBILITY IDEOGRAPH-2F9C1
+	0x2F9C2: 0x45F9,  // CJK COMPATIBILITY IDEOGRAPH-2F9C2
+	0x2F9C3: 0x8860,  // CJK COMPATIBILITY IDEOGRAPH-2F9C3
+	0x2F9C4: 0x8863,  // CJK COMPATIBILITY IDEOGRAPH-2F9C4
+	0x2F9C5: 0x27667, // CJK COMPATIBILITY IDEOGRAPH-2F9C5
+	0x2F9C6: 0x88D7,  // CJK COMPATIBILITY IDEOGRAPH-2F9C6
+	0x2F9C7: 0x88DE,  // CJK COMPATIBILITY IDEOGRAPH-2F9C7
+	0x2F9C8: 0x4635,  // CJK COMPATIBILITY IDEOGRAPH-2F9C8
+	0x2F9C9: 0x88FA,  // CJK COMPATIBILITY IDEOGRAPH-2F9C9
+	0x2F9CA: 0x34BB,  // CJK COMPATIBILITY IDEOGRAPH-2F9CA
+	0x2F9CB: 0x278AE, // CJK COMPATIBILITY IDEOGRAPH-2F9CB
+	0x2F9CC: 0x27966, // CJK COMPATIBILITY IDEOGRAPH-2F9CC
+	0x2F9CD: 0x46BE,  // CJK COMPATIBILITY IDEOGRAPH-2F9CD
+	0x2F9CE: 0x46C7,  // CJK COMPATIBILITY IDEOGRAPH-2F9CE
+	0x2F9CF: 0x8AA0,  // CJK COMPATIBILITY IDEOGRAPH-2F9CF
+	0x2F9D0: 0x8AED,  // CJK COMPATIBILITY IDEOGRAPH-2F9D0
+	0x2F9D1: 0x8B8A,  // CJK COMPATIBILITY IDEOGRAPH-2F9D1
+	0x2F9D2: 0x8C55,  // CJK COMPATIBILITY IDEOGRAPH-2F9D2
+	0x2F9D3: 0x27CA8, // CJK COMPATIBILITY IDEOGRAPH-2F9D3
+	0x2F9D4: 0x8CAB,  // CJK COMPATIBILITY IDEOGRAPH-2F9D4
+	0x2F9D5: 0x8CC1,  // CJK COMPATIBILITY IDEOGRAPH-2F9D5
+	0x2F9D6: 0x8D1B,  // CJK COMPATIBILITY IDEOGRAPH-2F9D6
+	0x2F9D7: 0x8D77,  // CJK COMPATIBILITY IDEOGRAPH-2F9D7
+	0x2F9D8: 0x27F2F, // CJK COMPATIBILITY IDEOGRAPH-2F9D8
+	0x2F9D9: 0x20804, // CJK COMPATIBILITY IDEOGRAPH-2F9D9
+	0x2F9DA: 0x8DCB,  // CJK COMPATIBILITY IDEOGRAPH-2F9DA
+	0x2F9DB: 0x8DBC,  // CJK COMPATIBILITY IDEOGRAPH-2F9DB
+	0x2F9DC: 0x8DF0,  // CJK COMPATIBILITY IDEOGRAPH-2F9DC
+	0x2F9DD: 0x208DE, // CJK COMPATIBILITY IDEOGRAPH-2F9DD
+	0x2F9DE: 0x8ED4,  // CJK COMPATIBILITY IDEOGRAPH-2F9DE
+	0x2F9DF: 0x8F38,  // CJK COMPATIBILITY IDEOGRAPH-2F9DF
+	0x2F9E0: 0x285D2, // CJK COMPATIBILITY IDEOGRAPH-2F9E0
+	0x2F9E1: 0x285ED, // CJK COMPATIBILITY IDEOGRAPH-2F9E1
+	0x2F9E2: 0x9094,  // CJK COMPATIBILITY IDEOGRAPH-2F9E2
+	0x2F9E3: 0x90F1,  // CJK COMPATIBILITY IDEOGRAPH-2F9E3
+	0x2F9E4: 0x9111,  // CJK COMPATIBILITY IDEOGRAPH-2F9E4
+	0x2F9E5: 0x2872E, // CJK COMPATIBILITY IDEOGRAPH-2F9E5
+	0x2F9E6: 0x911B,  // CJK COMPATIBILITY IDEOGRAPH-2F9E6
+	0x2F9E7: 0x9238,  // CJK COMPATIBILITY IDEOGRAPH-2F9E7
+	0x2F9E8: 0x92D7,  // CJK COMPATIBILITY IDEOGRAPH-2F9E8
+	0x2F9E9: 0x92D8,  // CJK COMPATIBILITY IDEOGRAPH-2F9E9
+	0x2F9EA: 0x927C,  // CJK COMPATIBILITY IDEOGRAPH-2F9EA
+	0x2F9EB: 0x93F9,  // CJK COMPATIBILITY IDEOGRAPH-2F9EB
+	0x2F9EC: 0x9415,  // CJK COMPATIBILITY IDEOGRAPH-2F9EC
+	0x2F9ED: 0x28BFA, // CJK COMPATIBILITY IDEOGRAPH-2F9ED
+	0x2F9EE: 0x958B,  // CJK COMPATIBILITY IDEOGRAPH-2F9EE
+	0x2F9EF: 0x4995,  // CJK COMPATIBILITY IDEOGRAPH-2F9EF
+	0x2F9F0: 0x95B7,  // CJK COMPATIBILITY IDEOGRAPH-2F9F0
+	0x2F9F1: 0x28D77, // CJK COMPATIBILITY IDEOGRAPH-2F9F1
+	0x2F9F2: 0x49E6,  // CJK COMPATIBILITY IDEOGRAPH-2F9F2
+	0x2F9F3: 0x96C3,  // CJK COMPATIBILITY IDEOGRAPH-2F9F3
+	0x2F9F4: 0x5DB2,  // CJK COMPATIBILITY IDEOGRAPH-2F9F4
+	0x2F9F5: 0x9723,  // CJK COMPATIBILITY IDEOGRAPH-2F9F5
+	0x2F9F6: 0x29145, // CJK COMPATIBILITY IDEOGRAPH-2F9F6
+	0x2F9F7: 0x2921A, // CJK COMPATIBILITY IDEOGRAPH-2F9F7
+	0x2F9F8: 0x4A6E,  // CJK COMPATIBILITY IDEOGRAPH-2F9F8
+	0x2F9F9: 0x4A76,  // CJK COMPATIBILITY IDEOGRAPH-2F9F9
+	0x2F9FA: 0x97E0,  // CJK COMPATIBILITY IDEOGRAPH-2F9FA
+	0x2F9FB: 0x2940A, // CJK COMPATIBILITY IDEOGRAPH-2F9FB
+	0x2F9FC: 0x4AB2,  // CJK COMPATIBILITY IDEOGRAPH-2F9FC
+	0x2F9FD: 0x29496, // CJK COMPATIBILITY IDEOGRAPH-2F9FD
+	0x2F9FE: 0x980B,  // CJK COMPATIBILITY IDEOGRAPH-2F9FE
+	0x2F9FF: 0x980B,  // CJK COMPATIBILITY IDEOGRAPH-2F9FF
+	0x2FA00: 0x9829,  // CJK COMPATIBILITY IDEOGRAPH-2FA00
+	0x2FA01: 0x295B6, // CJK COMPATIBILITY IDEOGRAPH-2FA01
+	0x2FA02: 0x98E2,  // CJK COMPATIBILITY IDEOGRAPH-2FA02
+	0x2FA03: 0x4B33,  // CJK COMPATIBILITY IDEOGRAPH-2FA03
+	0x2FA04: 0x9929,  // CJK COMPATIBILITY IDEOGRAPH-2FA04
+	0x2FA05: 0x99A7,  // CJK COMPATIBILITY IDEOGRAPH-2FA05
+	0x2FA06: 0x99C2,  // CJK COMPATIBILITY IDEOGRAPH-2FA06
+	0x2FA07: 0x99FE,  // CJK COMPATIBILITY IDEOGRAPH-2FA07
+	0x2FA08: 0x4BCE,  // CJK COMPATIBILITY IDEOGRAPH-2FA08
+	0x2FA09: 0x29B30, // CJK COMPATIBILITY IDEOGRAPH-2FA09
+	0x2FA0A: 0x9B12,  // CJK COMPATIBILITY IDEOGRAPH-2FA0A
+	0x2FA0B: 0x9C40,  // CJK COMPATIBILITY IDEOGRAPH-2FA0B
+	0x2FA0C: 0x9CFD,  // CJK COMPATIBILITY IDEOGRAPH-2FA0C
+	0x2FA0D: 0x4CCE,  // CJK COMPATIBILITY IDEOGRAPH-2FA0D
+	0x2FA0E: 0x4CED,  // CJK COMPATIBILITY IDEOGRAPH-2FA0E
+	0x2FA0F: 0x9D67,  // CJK COMPATIBILITY IDEOGRAPH-2FA0F
+	0x2FA10: 0x2A0CE, // CJK COMPATIBILITY IDEOGRAPH-2FA10
+	0x2FA11: 0x4CF8,  // CJK COMPATIBILITY IDEOGRAPH-2FA11
+	0x2FA12: 0x2A105, // CJK COMPATIBILITY IDEOGRAPH-2FA12
+	0x2FA13: 0x2A20E, // CJK COMPATIBILITY IDEOGRAPH-2FA13
+	0x2FA14: 0x2A291, // CJK COMPATIBILITY IDEOGRAPH-2FA14
+	0x2FA15: 0x9EBB,  // CJK COMPATIBILITY IDEOGRAPH-2FA15
+	0x2FA16: 0x4D56,  // CJK COMPATIBILITY IDEOGRAPH-2FA16
+	0x2FA17: 0x9EF9,  // CJK COMPATIBILITY IDEOGRAPH-2FA17
+	0x2FA18: 0x9EFE,  // CJK COMPATIBILITY IDEOGRAPH-2FA18
+	0x2FA19: 0x9F05,  // CJK COMPATIBILITY IDEOGRAPH-2FA19
+	0x2FA1A: 0x9F0F,  // CJK COMPATIBILITY IDEOGRAPH-2FA1A
+	0x2FA1B: 0x9F16,  // CJK COMPATIBILITY IDEOGRAPH-2FA1B
+	0x2FA1C: 0x9F3B,  // CJK COMPATIBILITY IDEOGRAPH-2FA1C
+	0x2FA1D: 0x2A600, // CJK COMPATIBILITY IDEOGRAPH-2FA1D
+}
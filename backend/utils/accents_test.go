@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStripAccents(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		offsets []int
+	}{
+		{"plain", "plain", nil},
+		{"été", "ete", []int{0, 1, 2, 3}},
+		{"e\u0301te\u0301", "ete", []int{0, 2, 3, 5}}, // combining accents
+		{"Ærø, Łódź", "Ærø, Łodz", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"Ǆ ñ ĳ", "Ǆ n ĳ", []int{0, 1, 2, 3, 4, 5}},
+		{"\u0301", "", []int{1}},
+	}
+	for _, test := range tests {
+		got, offsets := StripAccents(test.s)
+		if got != test.want || !reflect.DeepEqual(offsets, test.offsets) {
+			t.Errorf("StripAccents(%q) = %q %v, want %q %v", test.s, got, offsets, test.want, test.offsets)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Zero-width assertions are compiled as transitions on these symbols,
+// which are not valid runes. The matcher feeds them at the positions where
+// they hold (see assertAt), and automaton states without a transition on
+// one of them simply stay where they are.
+const (
+	lineStart       rune = -1 // ^
+	lineEnd         rune = -2 // $
+	wordBoundary    rune = -3 // \b
+	nonWordBoundary rune = -4 // \B
+)
+
+func isAssertion(r rune) bool {
+	return r < 0
+}
+
+// isWordRune reports whether r is part of a word for \b and \B, the runes
+// of wordClass.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// assertAt follows the assertion transitions holding at position i of the
+// line, before runes[i] is read. Assertions at the same position may appear
+// in any order in the pattern, so they are fed until the state is stable.
+func (s *DFAState) assertAt(runes []rune, i int) *DFAState {
+	if !s.asserts {
+		return s
+	}
+	before := i > 0 && isWordRune(runes[i-1])
+	after := i < len(runes) && isWordRune(runes[i])
+	boundary := nonWordBoundary
+	if before != after {
+		boundary = wordBoundary
+	}
+
+	for s.asserts {
+		prev := s
+		if i == 0 {
+			if next := s.step(lineStart); next != nil {
+				s = next
+			}
+		}
+		if i == len(runes) {
+			if next := s.step(lineEnd); next != nil {
+				s = next
+			}
+		}
+		if next := s.step(boundary); next != nil {
+			s = next
+		}
+		if s == prev {
+			break
+		}
+	}
+	return s
+}
+
+// assertionText is the regex syntax of an assertion symbol.
+func assertionText(r rune) string {
+	switch r {
+	case lineStart:
+		return "^"
+	case lineEnd:
+		return "$"
+	case wordBoundary:
+		return `\b`
+	case nonWordBoundary:
+		return `\B`
+	}
+	return ""
+}
+
+// symbolLabel is how a transition symbol is shown in DOT files.
+func symbolLabel(r rune) string {
+	if isAssertion(r) {
+		return strings.ReplaceAll(assertionText(r), `\`, `\\`)
+	}
+	if r == '"' || r == '\\' {
+		return `\` + string(r)
+	}
+	return strings.ReplaceAll(runeText(r), `\`, `\\`)
+}
@@ -0,0 +1,179 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// runeRange is the interval of runes [lo, hi].
+type runeRange struct {
+	lo, hi rune
+}
+
+// charClass is a set of runes kept as sorted, disjoint and non adjacent
+// ranges, so that [^a] or [À-ÿ] do not list every rune they match.
+type charClass struct {
+	ranges  []runeRange
+	negated bool // the class matches every rune outside ranges
+}
+
+// Predefined classes. \d and \s are ASCII only like RE2, \w holds every
+// Unicode letter and digit, as words do for \b (see isWordRune), so that
+// \w+ matches "café".
+var (
+	digitClass = []runeRange{{'0', '9'}}
+	wordClass  = tableRanges([]runeRange{{'_', '_'}}, unicode.Letter, unicode.Digit)
+	spaceClass = []runeRange{{'\t', '\r'}, {' ', ' '}} // \t \n \v \f \r and space
+)
+
+// tableRanges returns the ranges and the runes of the tables, normalized.
+func tableRanges(ranges []runeRange, tables ...*unicode.RangeTable) []runeRange {
+	for _, table := range tables {
+		for _, r := range table.R16 {
+			ranges = appendStride(ranges, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+		for _, r := range table.R32 {
+			ranges = appendStride(ranges, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+	}
+	return normalizeRanges(ranges)
+}
+
+// appendStride adds the runes lo, lo+stride, ... up to hi.
+func appendStride(ranges []runeRange, lo, hi, stride rune) []runeRange {
+	if stride == 1 {
+		return append(ranges, runeRange{lo, hi})
+	}
+	for r := lo; r <= hi; r += stride {
+		ranges = append(ranges, runeRange{r, r})
+	}
+	return ranges
+}
+
+// normalizeRanges sorts the ranges and merges the overlapping or adjacent
+// ones.
+func normalizeRanges(ranges []runeRange) []runeRange {
+	sorted := append([]runeRange{}, ranges...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].lo < sorted[j].lo })
+	merged := []runeRange{}
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && r.lo <= merged[n-1].hi+1 {
+			merged[n-1].hi = max(merged[n-1].hi, r.hi)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// complementRanges returns the runes outside the normalized ranges.
+func complementRanges(ranges []runeRange) []runeRange {
+	out := []runeRange{}
+	next := rune(0)
+	for _, r := range ranges {
+		if r.lo > next {
+			out = append(out, runeRange{next, r.lo - 1})
+		}
+		next = r.hi + 1
+	}
+	if next <= unicode.MaxRune {
+		out = append(out, runeRange{next, unicode.MaxRune})
+	}
+	return out
+}
+
+// matched returns the ranges of runes matched by the class, taking negation
+// into account.
+func (c charClass) matched() []runeRange {
+	if c.negated {
+		return complementRanges(c.ranges)
+	}
+	return c.ranges
+}
+
+// single returns the only rune matched by the class, if any.
+func (c charClass) single() (rune, bool) {
+	if !c.negated && len(c.ranges) == 1 && c.ranges[0].lo == c.ranges[0].hi {
+		return c.ranges[0].lo, true
+	}
+	return 0, false
+}
+
+// String is the class in regex syntax, e.g. [^0-9a-z].
+func (c charClass) String() string {
+	var b strings.Builder
+	b.WriteString("[")
+	if c.negated {
+		b.WriteString("^")
+	}
+	for _, r := range c.ranges {
+		b.WriteString(rangeText(r))
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// rangeText shows a range as "a" or "a-z", non printable runes escaped.
+func rangeText(r runeRange) string {
+	if r.lo == r.hi {
+		return runeText(r.lo)
+	}
+	return runeText(r.lo) + "-" + runeText(r.hi)
+}
+
+func runeText(r rune) string {
+	if !unicode.IsPrint(r) {
+		return fmt.Sprintf(`\x{%X}`, r)
+	}
+	return string(r)
+}
+
+// Case folding only changes the runes of this interval, like in regexp/syntax.
+const (
+	minFold = 0x0041
+	maxFold = 0x1e943
+)
+
+// foldOrbit returns r and the runes equal to it under Unicode simple case
+// folding, e.g. k, K and the Kelvin sign.
+func foldOrbit(r rune) []rune {
+	orbit := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		orbit = append(orbit, f)
+	}
+	return orbit
+}
+
+// foldRune maps every rune of a case folding orbit to the same rune, the
+// smallest one.
+func foldRune(r rune) rune {
+	folded := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		folded = min(folded, f)
+	}
+	return folded
+}
+
+// foldRanges adds to the normalized ranges the other case of their runes.
+func foldRanges(ranges []runeRange) []runeRange {
+	folded := append([]runeRange{}, ranges...)
+	for _, rr := range ranges {
+		for r := max(rr.lo, minFold); r <= min(rr.hi, maxFold); r++ {
+			for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+				folded = append(folded, runeRange{f, f})
+			}
+		}
+	}
+	return normalizeRanges(folded)
+}
+
+// foldedMatched is matched for case-insensitive matching : the class is
+// folded before being negated, so [^a] matches neither a nor A.
+func (c charClass) foldedMatched() []runeRange {
+	if c.negated {
+		return complementRanges(foldRanges(c.ranges))
+	}
+	return foldRanges(c.ranges)
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// minChunkSize is the smallest chunk ScanAt scans on its own, smaller
+// files are split in fewer chunks.
+const minChunkSize = 64 << 10
+
+// chunkScan is the outcome of the scan of one chunk, line numbers starting
+// at 1 at the beginning of the chunk.
+type chunkScan struct {
+	lines   int
+	count   int
+	matches []LineMatch
+	head    []ContextLine // first lines, the context after a match of the previous chunks
+	tail    []ContextLine // last lines, the context before a match of the next chunks
+	err     error
+}
+
+// ScanAt is Scan over the first size bytes of r, split in chunks starting
+// at the beginning of a line and scanned at the same time. Matches are
+// merged in line order with their line number in the whole text, and
+// context lines are shared across chunks as Scan does. lines is the number
+// of lines read.
+func (m *matcher) ScanAt(r io.ReaderAt, size int64, chunks int) (number_matches int, matches []LineMatch, lines int, err error) {
+	bounds, err := chunkBounds(r, size, chunks)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+
+	scans := make([]chunkScan, len(bounds)-1)
+	var wg sync.WaitGroup
+	for i := range scans {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			scans[i] = m.scanChunk(io.NewSectionReader(r, bounds[i], bounds[i+1]-bounds[i]))
+		}(i)
+	}
+	wg.Wait()
+
+	// line numbers in the whole text, and every line kept by a chunk
+	matches = []LineMatch{}
+	known := map[int]string{}
+	for _, scan := range scans {
+		if scan.err != nil {
+			return 0, nil, 0, scan.err
+		}
+		for _, match := range scan.matches {
+			match.Line += lines
+			matches = append(matches, match)
+			known[match.Line] = match.Text
+			keepLines(known, lines, match.Before, match.After)
+		}
+		keepLines(known, lines, scan.head, scan.tail)
+		number_matches += scan.count
+		lines += scan.lines
+	}
+	if m.context != (ContextSize{}) {
+		m.context.attach(matches, known, lines)
+	}
+	return number_matches, matches, lines, nil
+}
+
+// keepLines adds the lines of a chunk starting after line first to known.
+func keepLines(known map[int]string, first int, groups ...[]ContextLine) {
+	for _, group := range groups {
+		for _, line := range group {
+			known[first+line.Line] = line.Text
+		}
+	}
+}
+
+func (m *matcher) scanChunk(r io.Reader) chunkScan {
+	collector := newLineCollector(m.context)
+	tail := newLineRing(m.context.Before)
+	scan := chunkScan{}
+	scan.err = readLines(r, func(text string) {
+		scan.lines++
+		line := ContextLine{Line: scan.lines, Text: text}
+		if scan.lines <= m.context.After {
+			scan.head = append(scan.head, line)
+		}
+		tail.push(line)
+		collector.add(line.Line, line.Text, m.FindAll(line.Text))
+	})
+	scan.count, scan.matches, scan.tail = collector.count, collector.matches, tail.drain()
+	return scan
+}
+
+// attach sets the context lines of matches, sorted by line, as
+// lineCollector does : the lines before a match start after the lines
+// shown for the previous one. known holds every line needed.
+func (c ContextSize) attach(matches []LineMatch, known map[int]string, lines int) {
+	shown := 0 // last line shown
+	for i := range matches {
+		match := &matches[i]
+		match.Before, match.After = nil, nil
+		for line := max(match.Line-c.Before, shown+1); line < match.Line; line++ {
+			match.Before = append(match.Before, ContextLine{Line: line, Text: known[line]})
+		}
+		last := min(match.Line+c.After, lines)
+		if i+1 < len(matches) {
+			last = min(last, matches[i+1].Line-1)
+		}
+		for line := match.Line + 1; line <= last; line++ {
+			match.After = append(match.After, ContextLine{Line: line, Text: known[line]})
+		}
+		shown = max(match.Line, last)
+	}
+}
+
+// chunkBounds splits the first size bytes of r in at most chunks ranges of
+// at least minChunkSize bytes, each starting at the beginning of a line.
+// Chunk i is bounds[i]:bounds[i+1].
+func chunkBounds(r io.ReaderAt, size int64, chunks int) ([]int64, error) {
+	chunks = int(min(int64(chunks), size/minChunkSize))
+	bounds := []int64{0}
+	for i := 1; i < chunks; i++ {
+		start, err := nextLineStart(r, size, size*int64(i)/int64(chunks))
+		if err != nil {
+			return nil, err
+		}
+		// a long line may span several chunks
+		if start > bounds[len(bounds)-1] && start < size {
+			bounds = append(bounds, start)
+		}
+	}
+	return append(bounds, size), nil
+}
+
+// nextLineStart returns the offset of the first line starting at or after
+// pos, size when there is none.
+func nextLineStart(r io.ReaderAt, size int64, pos int64) (int64, error) {
+	buf := make([]byte, 4096)
+	for offset := pos - 1; offset < size; {
+		n, err := r.ReadAt(buf[:min(int64(len(buf)), size-offset)], offset)
+		if i := bytes.IndexByte(buf[:n], '\n'); i >= 0 {
+			return offset + int64(i) + 1, nil
+		}
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+		if n == 0 {
+			break
+		}
+		offset += int64(n)
+	}
+	return size, nil
+}
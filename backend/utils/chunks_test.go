@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// randomText returns lines of random words, some lines being longer than a
+// chunk so that chunks are merged.
+func randomText(r *rand.Rand, size int) string {
+	words := []string{"Sargon", "sargon", "Babylon", "the", "of", "king", "été", "and", "Assyria", "1820"}
+	var b strings.Builder
+	for b.Len() < size {
+		n := r.Intn(12)
+		if r.Intn(500) == 0 {
+			n = 12000
+		}
+		for i := 0; i < n; i++ {
+			b.WriteString(words[r.Intn(len(words))])
+			b.WriteString(" ")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// TestScanAt checks that ScanAt finds the same matches and context lines
+// as Scan, whatever the number of chunks.
+func TestScanAt(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	text := randomText(r, 300<<10)
+	texts := []string{
+		text,
+		strings.TrimSuffix(text, "\n"), // no '\n' after the last line
+		"Sargon\n\nSargon",
+		"",
+	}
+	contexts := []ContextSize{{}, {Before: 2}, {After: 3}, {Before: 10, After: 10}}
+	for _, pattern := range []string{"Sargon", `\bking\b`, "^été|Assyria $"} {
+		for _, context := range contexts {
+			m, err := Compile(pattern, Options{IgnoreCase: true, Context: context})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, text := range texts {
+				want_count, want, want_lines, err := m.Scan(strings.NewReader(text))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if n := strings.Count(strings.TrimSuffix(text, "\n"), "\n") + 1; text != "" && want_lines != n {
+					t.Fatalf("Scan read %d lines, want %d", want_lines, n)
+				}
+				for _, chunks := range []int{2, 3, 16} {
+					count, matches, lines, err := m.ScanAt(strings.NewReader(text), int64(len(text)), chunks)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if count != want_count || lines != want_lines {
+						t.Fatalf("%q %+v, %d bytes in %d chunks : got %d matches in %d lines, want %d in %d lines", pattern, context, len(text), chunks, count, lines, want_count, want_lines)
+					}
+					if !reflect.DeepEqual(matches, want) {
+						t.Fatalf("%q %+v, %d bytes in %d chunks : matching or context lines differ from Scan", pattern, context, len(text), chunks)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Matching algorithms of Options.Algo
+const (
+	AlgoAuto  = "auto" // kmp for plain strings, regex otherwise, see DetectAlgo
+	AlgoRegex = "regex"
+	AlgoKMP   = "kmp"
+)
+
+// Options select how a pattern is compiled and matched. The zero value
+// picks the algorithm from the pattern and matches case and accents
+// exactly, without context lines.
+type Options struct {
+	Algo          string    // AlgoAuto (or ""), AlgoRegex or AlgoKMP
+	Mode          MatchMode // regex, which match to report
+	IgnoreCase    bool
+	IgnoreAccents bool        // see StripAccents
+	Context       ContextSize // lines kept around matches by Scan
+}
+
+// Matcher finds a compiled pattern in text. Offsets are rune offsets in the
+// original line, also when accents are ignored. A Matcher is never modified
+// once compiled and can be shared between goroutines.
+type Matcher interface {
+	// MatchLine reports whether the line contains the pattern.
+	MatchLine(line string) bool
+	// FindAll returns the non-overlapping occurrences of the pattern in the
+	// line, from left to right.
+	FindAll(line string) []Match
+	// Scan reads r line by line and returns every matching line with all its
+	// occurrences and its context lines. number_matches counts occurrences,
+	// not lines, and lines is the number of lines read. Lines may be of any
+	// length, and read errors are returned.
+	Scan(r io.Reader) (number_matches int, matches []LineMatch, lines int, err error)
+	// ScanAt is Scan over the first size bytes of r, split in at most chunks
+	// parts scanned in parallel, for large files. lines is the number of
+	// lines read.
+	ScanAt(r io.ReaderAt, size int64, chunks int) (number_matches int, matches []LineMatch, lines int, err error)
+	// Literals returns strings every match contains, see RequiredLiterals.
+	Literals() []string
+}
+
+// DetectAlgo picks regex matching when the pattern contains any regex
+// special character, and KMP for plain strings.
+func DetectAlgo(pattern string) string {
+	if strings.ContainsAny(pattern, `|.*+?()[]{}\^$`) {
+		return AlgoRegex
+	}
+	return AlgoKMP
+}
+
+// Compile compiles the pattern for the algorithm of opts. Regex syntax
+// errors are *SyntaxError. Patterns can be compiled from several
+// goroutines at the same time.
+func Compile(pattern string, opts Options) (Matcher, error) {
+	source := pattern
+	if opts.IgnoreAccents {
+		// e.g. a lone combining accent
+		source, _ = StripAccents(pattern)
+	}
+	if source == "" {
+		return nil, errors.New("empty pattern")
+	}
+	algo := opts.Algo
+	if algo == "" || algo == AlgoAuto {
+		algo = DetectAlgo(pattern)
+	}
+
+	m := &matcher{ignoreAccents: opts.IgnoreAccents, context: opts.Context}
+	switch algo {
+	case AlgoRegex:
+		tree, err := (&RegexTreeNode{}).ParseRegex(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if opts.IgnoreAccents {
+			tree.StripAccents()
+		}
+		dfa, err := NFAToDFA(BuildNFA(tree, opts.IgnoreCase))
+		if err != nil {
+			return nil, err
+		}
+		m.engine = regexEngine{start: dfa.Minimize().Start, mode: opts.Mode}
+		m.literals = tree.RequiredLiterals()
+	case AlgoKMP:
+		folded := source
+		if opts.IgnoreCase {
+			folded = FoldCase(source)
+		}
+		m.engine = kmpEngine{pattern: folded, co: CreateCarryOverTable(folded), foldCase: opts.IgnoreCase}
+		m.literals = []string{source}
+	default:
+		return nil, fmt.Errorf("unknown algo %q, expected %s, %s or %s", opts.Algo, AlgoAuto, AlgoRegex, AlgoKMP)
+	}
+	return m, nil
+}
+
+// engine finds the occurrences of a pattern in a line, once accents are
+// stripped when they are ignored.
+type engine interface {
+	find(text string) []Match
+}
+
+// regexEngine runs the minimized DFA, see findAllInText.
+type regexEngine struct {
+	start *DFAState
+	mode  MatchMode
+}
+
+func (e regexEngine) find(text string) []Match {
+	return findAllInText(e.start, text, e.mode)
+}
+
+// kmpEngine searches a string with its carry over table. With foldCase,
+// pattern is folded, see FoldCase.
+type kmpEngine struct {
+	pattern  string
+	co       []int
+	foldCase bool
+}
+
+func (e kmpEngine) find(text string) []Match {
+	return kmpFindAll(e.pattern, text, e.co, e.foldCase)
+}
+
+// matcher implements Matcher for both engines.
+type matcher struct {
+	engine
+	ignoreAccents bool
+	context       ContextSize
+	literals      []string
+}
+
+func (m *matcher) MatchLine(line string) bool {
+	if m.ignoreAccents {
+		line, _ = StripAccents(line)
+	}
+	return len(m.find(line)) > 0
+}
+
+func (m *matcher) FindAll(line string) []Match {
+	text, offsets := line, []int(nil)
+	if m.ignoreAccents {
+		text, offsets = StripAccents(line)
+	}
+	return originalMatches(m.find(text), offsets)
+}
+
+func (m *matcher) Scan(r io.Reader) (number_matches int, matches []LineMatch, lines int, err error) {
+	collector := newLineCollector(m.context)
+	err = readLines(r, func(line string) {
+		lines++
+		collector.add(lines, line, m.FindAll(line))
+	})
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	return collector.count, collector.matches, lines, nil
+}
+
+func (m *matcher) Literals() []string {
+	return m.literals
+}
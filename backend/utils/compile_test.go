@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// spans shows matches as the matched texts, e.g. [ab cd].
+func spans(line string, matches []Match) []string {
+	runes := []rune(line)
+	out := []string{}
+	for _, m := range matches {
+		out = append(out, string(runes[m.Start:m.End]))
+	}
+	return out
+}
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opts    Options
+		line    string
+		want    []string
+	}{
+		// every occurrence, not overlapping
+		{"S((a|r|g)*)on", Options{}, "Sargon and Son, not Sagon", []string{"Sargon", "Son", "Sagon"}},
+		{"on", Options{Algo: AlgoKMP}, "on son onon", []string{"on", "on", "on", "on"}},
+		{"aa", Options{Algo: AlgoKMP}, "aaaaa", []string{"aa", "aa"}},
+		{"(aa)*a", Options{}, "aaaaa", []string{"aaaaa"}},
+		{"Sargon", Options{}, "Son", []string{}},
+		// leftmost longest and shortest
+		{"a|ab", Options{}, "abab", []string{"ab", "ab"}},
+		{"a|ab", Options{Mode: LeftmostShortest}, "abab", []string{"a", "a"}},
+		{"a+", Options{}, "caaat", []string{"aaa"}},
+		{"a+", Options{Mode: LeftmostShortest}, "caaat", []string{"a", "a", "a"}},
+		{"(a|ab)(c|bcd)", Options{}, "abcd", []string{"abcd"}},
+		{"b*c|ab", Options{}, "abc", []string{"ab", "c"}}, // leftmost first
+		// anchors
+		{"^a", Options{}, "aaa", []string{"a"}},
+		{"a$", Options{}, "aaa", []string{"a"}},
+		{"^a+$", Options{}, "aaa", []string{"aaa"}},
+		{"^a+$", Options{}, "aab", []string{}},
+		{"^$", Options{}, "x", []string{}},
+		{"(^S|n$)", Options{}, "Son Sin", []string{"S", "n"}},
+		{"a^", Options{}, "a^a", []string{}},
+		// dot
+		{".a.", Options{}, "banana", []string{"ban"}},
+		{".a.", Options{}, "bananas", []string{"ban", "nas"}},
+		{"S.n", Options{}, "Son Sin Sén S\tn", []string{"Son", "Sin", "Sén", "S\tn"}},
+		{"a.*a", Options{}, "xabaax", []string{"abaa"}},
+		// escapes and predefined classes
+		{`S\.n`, Options{}, "S.n Son", []string{"S.n"}},
+		{`\(\d+\)`, Options{}, "note (44) and (b)", []string{"(44)"}},
+		{`\d+\s\D`, Options{}, "12 b 3 4", []string{"12 b"}},
+		{`\S+`, Options{}, " ab\tc ", []string{"ab", "c"}},
+		{`[\w']+`, Options{}, "it's ok", []string{"it's", "ok"}},
+		{`\bon\b`, Options{}, "on son on, onto", []string{"on", "on"}},
+		{`\Bon\B`, Options{}, "on son bonus", []string{"on"}},
+		{`\b\w+\b`, Options{}, "le café est là", []string{"le", "café", "est", "là"}},
+		{`\W+`, Options{}, "été, là", []string{", "}},
+		// classes
+		{"[0-9]+", Options{}, "in 1820 or 605", []string{"1820", "605"}},
+		{"[^a-z ]+", Options{}, "abc DEF gh IJ", []string{"DEF", "IJ"}},
+		{"[]a]+", Options{}, "x]a]y", []string{"]a]"}},
+		{"[a-]+", Options{}, "b-a-c", []string{"-a-"}},
+		{"[^]]+", Options{}, "a]b", []string{"a", "b"}},
+		{"[À-ÿ]+", Options{}, "déjà vu", []string{"é", "à"}},
+		{"[α-ω]+", Options{}, "alpha αβγ", []string{"αβγ"}},
+		{"[^a]", Options{}, "aéa", []string{"é"}},
+		// counted repetitions
+		{"a{2}", Options{}, "aaaaa", []string{"aa", "aa"}},
+		{"a{2,}", Options{}, "a aa aaaaa", []string{"aa", "aaaaa"}},
+		{"a{1,3}", Options{}, "aaaaa", []string{"aaa", "aa"}},
+		{"(ab){0,2}c", Options{}, "ababababc", []string{"ababc"}},
+		{"x{,2}", Options{}, "x{,2}", []string{"x{,2}"}},
+		{"a{2}{3}", Options{}, "aaaaaaa", []string{"aaaaaa"}},
+		// -i
+		{"sargon", Options{IgnoreCase: true}, "SARGON Sargon", []string{"SARGON", "Sargon"}},
+		{"sargon", Options{IgnoreCase: true, Algo: AlgoKMP}, "SARGON Sargon", []string{"SARGON", "Sargon"}},
+		{"[a-c]+", Options{IgnoreCase: true}, "xAbCx", []string{"AbC"}},
+		{"[^a]", Options{IgnoreCase: true}, "aAb", []string{"b"}},
+		{"été", Options{IgnoreCase: true, Algo: AlgoKMP}, "ÉTÉ", []string{"ÉTÉ"}},
+		{"s", Options{IgnoreCase: true, Algo: AlgoKMP}, "ſ S", []string{"ſ", "S"}},
+		{"k", Options{IgnoreCase: true}, "\u212a K", []string{"\u212a", "K"}}, // Kelvin sign
+		// -a, offsets are those of the original line
+		{"ete", Options{IgnoreAccents: true}, "été, l'ete", []string{"été", "ete"}},
+		{"été", Options{IgnoreAccents: true}, "ete", []string{"ete"}},
+		{"ét", Options{IgnoreAccents: true}, "et", []string{"et"}},
+		{"ete", Options{IgnoreAccents: true, Algo: AlgoKMP}, "l'été", []string{"été"}},
+		{"e\u0301te\u0301", Options{IgnoreAccents: true}, "été", []string{"été"}}, // combining accents
+		{"caf[é]", Options{IgnoreAccents: true}, "café cafe", []string{"café", "cafe"}},
+		{"[à-ÿ]", Options{IgnoreAccents: true}, "b", []string{}},
+		{"[^é]", Options{IgnoreAccents: true}, "eé", []string{}},
+		{"ÉTÉ", Options{IgnoreCase: true, IgnoreAccents: true}, "l'ete", []string{"ete"}},
+	}
+	for _, test := range tests {
+		m, err := Compile(test.pattern, test.opts)
+		if err != nil {
+			t.Errorf("Compile(%q) : %v", test.pattern, err)
+			continue
+		}
+		if got := spans(test.line, m.FindAll(test.line)); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q %+v in %q : got %q, want %q", test.pattern, test.opts, test.line, got, test.want)
+		}
+	}
+}
+
+// Offsets are rune offsets, whatever the algorithm.
+func TestFindAllOffsets(t *testing.T) {
+	line := "un café, deux cafés"
+	want := []Match{{Start: 3, End: 7}, {Start: 14, End: 18}}
+	for _, algo := range []string{AlgoRegex, AlgoKMP} {
+		m, err := Compile("café", Options{Algo: algo})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := m.FindAll(line); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s : got %v, want %v", algo, got, want)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opts    Options
+	}{
+		{"", Options{}},
+		{"a", Options{Algo: "grep"}},
+		{"\u0301", Options{IgnoreAccents: true}}, // only combining accents
+		{"\u0301\u0300", Options{IgnoreAccents: true, Algo: AlgoRegex}},
+		{"(a|b)*a(a|b){20}", Options{}}, // too many DFA states
+	}
+	for _, test := range tests {
+		if _, err := Compile(test.pattern, test.opts); err == nil {
+			t.Errorf("Compile(%q, %+v) : expected an error", test.pattern, test.opts)
+		}
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	if got := DetectAlgo("Sargon"); got != AlgoKMP {
+		t.Errorf("DetectAlgo(Sargon) = %s, want %s", got, AlgoKMP)
+	}
+	if got := DetectAlgo("Sarg(o|a)n"); got != AlgoRegex {
+		t.Errorf("DetectAlgo(Sarg(o|a)n) = %s, want %s", got, AlgoRegex)
+	}
+
+	text := "Sargon\nnothing\nSargon and Sargon\n"
+	for _, algo := range []string{AlgoAuto, AlgoRegex, AlgoKMP} {
+		m, err := Compile("Sargon", Options{Algo: algo})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !m.MatchLine("the Sargon") || m.MatchLine("Sa rgon") {
+			t.Errorf("%s : wrong MatchLine", algo)
+		}
+		if got := m.Literals(); !reflect.DeepEqual(got, []string{"Sargon"}) {
+			t.Errorf("%s : Literals = %q", algo, got)
+		}
+		number_matches, matches, lines, err := m.Scan(strings.NewReader(text))
+		if err != nil || number_matches != 3 || lines != 3 || len(matches) != 2 || matches[1].Line != 3 {
+			t.Errorf("%s : Scan = %d %v %d %v", algo, number_matches, matches, lines, err)
+		}
+	}
+}
+
+// The deprecated wrappers find the same lines as Scan.
+func TestDeprecated(t *testing.T) {
+	text := "a Sargon\nb\nc\nSargon Sargon\nd\n"
+	context := ContextSize{Before: 1, After: 1}
+	m, err := Compile("Sarg(o|a)n", Options{Context: context})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, want, _, _ := m.Scan(strings.NewReader(text))
+
+	start := m.(*matcher).engine.(regexEngine).start
+	matched, number_matches, got := MatchAllText(start, bufio.NewScanner(strings.NewReader(text)), LeftmostLongest, false, context)
+	if !matched || number_matches != 3 || !reflect.DeepEqual(got, want) {
+		t.Errorf("MatchAllText = %v %d %v, want %v", matched, number_matches, got, want)
+	}
+
+	pattern := FoldCase("sargon")
+	matched, number_matches, got = KMPSearch(pattern, bufio.NewScanner(strings.NewReader(text)), CreateCarryOverTable(pattern), true, false, context)
+	if !matched || number_matches != 3 || !reflect.DeepEqual(got, want) {
+		t.Errorf("KMPSearch = %v %d %v, want %v", matched, number_matches, got, want)
+	}
+}
+
+// randomPattern returns a regex without assertions on the runes of "ab c".
+func randomPattern(r *rand.Rand, depth int) string {
+	if depth == 0 {
+		atoms := []string{"a", "b", "c", " "}
+		atoms = append(atoms, ".")
+		atoms = append(atoms, `\w`, `\s`, `\.`)
+		atoms = append(atoms, "[ab]", "[^a]", "[a-c ]")
+		return atoms[r.Intn(len(atoms))]
+	}
+	sub := func() string { return randomPattern(r, depth-1) }
+	switch r.Intn(8) {
+	case 0:
+		return sub() + "|" + sub()
+	case 1:
+		return "(" + sub() + ")*"
+	case 2:
+		return "(" + sub() + ")+"
+	case 3:
+		return "(" + sub() + ")?"
+	case 4:
+		lo := r.Intn(3)
+		return "(" + sub() + ")" + []string{fmt.Sprintf("{%d}", lo+1), fmt.Sprintf("{%d,}", lo), fmt.Sprintf("{%d,%d}", lo, lo+1+r.Intn(2))}[r.Intn(3)]
+	default:
+		return "(" + sub() + ")" + sub()
+	}
+}
+
+// TestCompareRegexp compares the matches of random patterns with the
+// leftmost longest matches of regexp, on ASCII text where rune offsets are
+// byte offsets.
+func TestCompareRegexp(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for compared := 0; compared < 500; {
+		pattern := randomPattern(r, 1+r.Intn(3))
+		re := regexp.MustCompile(pattern)
+		re.Longest()
+		if re.MatchString("") {
+			continue // regexp and the DFA skip empty matches differently
+		}
+		compared++
+		m, err := Compile(pattern, Options{Algo: AlgoRegex})
+		if err != nil {
+			t.Fatalf("Compile(%q) : %v", pattern, err)
+		}
+		for k := 0; k < 50; k++ {
+			line := make([]byte, r.Intn(15))
+			for j := range line {
+				line[j] = "ab c."[r.Intn(5)]
+			}
+			want := []Match{}
+			for _, loc := range re.FindAllStringIndex(string(line), -1) {
+				want = append(want, Match{Start: loc[0], End: loc[1]})
+			}
+			if got := m.FindAll(string(line)); !reflect.DeepEqual(got, want) {
+				t.Fatalf("%q in %q : got %v, want %v", pattern, line, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package utils
+
+// ContextSize is the number of lines kept before and after every matching
+// line, like grep -B and -A.
+type ContextSize struct {
+	Before int
+	After  int
+}
+
+// ContextLine is a line shown around a match.
+type ContextLine struct {
+	Line int // line number, starting at 1
+	Text string
+}
+
+// lineRing keeps the last lines read, up to its capacity.
+type lineRing struct {
+	lines []ContextLine
+	start int // index of the oldest line
+	size  int
+}
+
+func newLineRing(capacity int) *lineRing {
+	return &lineRing{lines: make([]ContextLine, capacity)}
+}
+
+func (r *lineRing) push(line ContextLine) {
+	if len(r.lines) == 0 {
+		return
+	}
+	if r.size < len(r.lines) {
+		r.lines[(r.start+r.size)%len(r.lines)] = line
+		r.size++
+		return
+	}
+	// full, the oldest line is overwritten
+	r.lines[r.start] = line
+	r.start = (r.start + 1) % len(r.lines)
+}
+
+// drain returns the lines from the oldest and empties the ring.
+func (r *lineRing) drain() []ContextLine {
+	if r.size == 0 {
+		return nil
+	}
+	lines := make([]ContextLine, r.size)
+	for i := range lines {
+		lines[i] = r.lines[(r.start+i)%len(r.lines)]
+	}
+	r.start, r.size = 0, 0
+	return lines
+}
+
+// lineCollector gathers the matching lines of a text read line by line,
+// with their context. Lines before a match come from a ring buffer of the
+// last lines read, and the lines after it are added as they are read, until
+// the next match or until enough lines were read. A line is never shown
+// twice : the context of close matches is shared, see FirstLine.
+type lineCollector struct {
+	context  ContextSize
+	previous *lineRing
+	after    int // lines still to add after the last match
+	count    int
+	matches  []LineMatch
+}
+
+func newLineCollector(context ContextSize) *lineCollector {
+	return &lineCollector{context: context, previous: newLineRing(context.Before), matches: []LineMatch{}}
+}
+
+// add records a line and the matches found in it.
+func (c *lineCollector) add(number int, text string, found []Match) {
+	if len(found) > 0 {
+		c.matches = append(c.matches, LineMatch{Line: number, Text: text, Matches: found, Before: c.previous.drain()})
+		c.count += len(found)
+		c.after = c.context.After
+		return
+	}
+	if c.after > 0 {
+		last := &c.matches[len(c.matches)-1]
+		last.After = append(last.After, ContextLine{Line: number, Text: text})
+		c.after--
+		return
+	}
+	c.previous.push(ContextLine{Line: number, Text: text})
+}
+
+// FirstLine is the number of the first line shown for the match, context
+// included.
+func (m LineMatch) FirstLine() int {
+	if len(m.Before) > 0 {
+		return m.Before[0].Line
+	}
+	return m.Line
+}
+
+// LastLine is the number of the last line shown for the match, context
+// included. Groups of lines are separated like grep's "--" when the next
+// match's FirstLine is more than LastLine+1.
+func (m LineMatch) LastLine() int {
+	if len(m.After) > 0 {
+		return m.After[len(m.After)-1].Line
+	}
+	return m.Line
+}
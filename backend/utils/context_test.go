@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// contextLines numbers the lines first..last of text "line <n>".
+func contextLines(first, last int) []ContextLine {
+	lines := []ContextLine{}
+	for n := first; n <= last; n++ {
+		lines = append(lines, ContextLine{Line: n, Text: fmt.Sprintf("line %d", n)})
+	}
+	return lines
+}
+
+func TestLineCollector(t *testing.T) {
+	found := []Match{{Start: 0, End: 4}}
+	matching := map[int]bool{3: true, 4: true, 9: true}
+	collector := newLineCollector(ContextSize{Before: 2, After: 1})
+	for n := 1; n <= 10; n++ {
+		if matching[n] {
+			collector.add(n, fmt.Sprintf("line %d", n), found)
+		} else {
+			collector.add(n, fmt.Sprintf("line %d", n), nil)
+		}
+	}
+
+	// the context of close matches is shared, never shown twice
+	want := []LineMatch{
+		{Line: 3, Text: "line 3", Matches: found, Before: contextLines(1, 2)},
+		{Line: 4, Text: "line 4", Matches: found, After: contextLines(5, 5)},
+		{Line: 9, Text: "line 9", Matches: found, Before: contextLines(7, 8), After: contextLines(10, 10)},
+	}
+	if collector.count != 3 || !reflect.DeepEqual(collector.matches, want) {
+		t.Fatalf("got %d %+v, want %+v", collector.count, collector.matches, want)
+	}
+
+	groups := [][2]int{}
+	for _, m := range collector.matches {
+		if len(groups) > 0 && m.FirstLine() <= groups[len(groups)-1][1]+1 {
+			groups[len(groups)-1][1] = m.LastLine()
+			continue
+		}
+		groups = append(groups, [2]int{m.FirstLine(), m.LastLine()})
+	}
+	if want := [][2]int{{1, 5}, {7, 10}}; !reflect.DeepEqual(groups, want) {
+		t.Errorf("groups of lines = %v, want %v", groups, want)
+	}
+}
+
+func TestScanContext(t *testing.T) {
+	text := ""
+	for n := 1; n <= 6; n++ {
+		text += fmt.Sprintf("line %d\n", n)
+	}
+	tests := []struct {
+		context ContextSize
+		want    []LineMatch
+	}{
+		{ContextSize{}, []LineMatch{
+			{Line: 1, Text: "line 1", Matches: []Match{{Start: 5, End: 6}}},
+			{Line: 5, Text: "line 5", Matches: []Match{{Start: 5, End: 6}}},
+		}},
+		{ContextSize{Before: 3, After: 3}, []LineMatch{
+			{Line: 1, Text: "line 1", Matches: []Match{{Start: 5, End: 6}}, After: contextLines(2, 4)},
+			{Line: 5, Text: "line 5", Matches: []Match{{Start: 5, End: 6}}, After: contextLines(6, 6)},
+		}},
+		{ContextSize{Before: 1}, []LineMatch{
+			{Line: 1, Text: "line 1", Matches: []Match{{Start: 5, End: 6}}},
+			{Line: 5, Text: "line 5", Matches: []Match{{Start: 5, End: 6}}, Before: contextLines(4, 4)},
+		}},
+	}
+	for _, test := range tests {
+		m, err := Compile("[15]", Options{Context: test.context})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, matches, _, err := m.Scan(strings.NewReader(text))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(matches, test.want) {
+			t.Errorf("context %+v : got %+v, want %+v", test.context, matches, test.want)
+		}
+	}
+}
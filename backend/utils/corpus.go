@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Book is one text of the corpus, either a file on disk or a text
+// embedded in books.json (see extract_books.py).
+type Book struct {
+	ID      string // books.json id, or the file name without .txt
+	Title   string // falls back to the id when unknown
+	Authors []string
+	Path    string // text file, empty when Text is set
+	Text    string
+}
+
+// Open returns a reader over the book's text.
+func (b *Book) Open() (io.ReadCloser, error) {
+	if b.Path == "" {
+		return io.NopCloser(strings.NewReader(b.Text)), nil
+	}
+	return os.Open(b.Path)
+}
+
+// Stamp identifies the version of the book's text : the size and the
+// modification time of its file, or the size of a text embedded in
+// books.json, whose modification time is zero.
+func (b *Book) Stamp() (size int64, modTime time.Time, err error) {
+	if b.Path == "" {
+		return int64(len(b.Text)), time.Time{}, nil
+	}
+	info, err := os.Stat(b.Path)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return info.Size(), info.ModTime(), nil
+}
+
+// books.json entry as written by extract_books.py
+type bookEntry struct {
+	Title   string   `json:"title"`
+	Authors []string `json:"authors"`
+	Text    string   `json:"text"`
+}
+
+// readBooksJSON reads books.json into entries by id. Entries may also be
+// plain titles ({"id": "title"}).
+func readBooksJSON(path string) (map[string]bookEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	entries := make(map[string]bookEntry, len(raw))
+	for id, msg := range raw {
+		var entry bookEntry
+		var title string
+		if err := json.Unmarshal(msg, &title); err == nil {
+			entry.Title = title
+		} else if err := json.Unmarshal(msg, &entry); err != nil {
+			return nil, fmt.Errorf("%s: book %s: %w", path, id, err)
+		}
+		entries[id] = entry
+	}
+	return entries, nil
+}
+
+// LoadBooksJSON returns every book listed in books.json. Books without an
+// embedded text are read from <id>.txt next to the json file.
+func LoadBooksJSON(path string) ([]Book, error) {
+	entries, err := readBooksJSON(path)
+	if err != nil {
+		return nil, err
+	}
+
+	books := []Book{}
+	for id, entry := range entries {
+		book := Book{ID: id, Title: entry.Title, Authors: entry.Authors, Text: entry.Text}
+		if book.Title == "" {
+			book.Title = id
+		}
+		if book.Text == "" {
+			book.Path = filepath.Join(filepath.Dir(path), id+".txt")
+			if _, err := os.Stat(book.Path); err != nil {
+				return nil, fmt.Errorf("book %s has no text in %s: %w", id, path, err)
+			}
+		}
+		books = append(books, book)
+	}
+	sortBooks(books)
+	return books, nil
+}
+
+// LoadBooksDir returns every .txt file of dir as a book. Titles and
+// authors are taken from dir/books.json when it exists.
+func LoadBooksDir(dir string) ([]Book, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	entries := map[string]bookEntry{}
+	if _, err := os.Stat(filepath.Join(dir, "books.json")); err == nil {
+		entries, err = readBooksJSON(filepath.Join(dir, "books.json"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	books := []Book{}
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".txt" {
+			continue
+		}
+		id := strings.TrimSuffix(f.Name(), ".txt")
+		book := Book{ID: id, Title: id, Path: filepath.Join(dir, f.Name())}
+		if entry, ok := entries[id]; ok {
+			if entry.Title != "" {
+				book.Title = entry.Title
+			}
+			book.Authors = entry.Authors
+		}
+		books = append(books, book)
+	}
+	sortBooks(books)
+	return books, nil
+}
+
+// LoadCorpus loads the books found at path, which is either a directory of
+// .txt files, a books.json file, or a single text file.
+func LoadCorpus(path string) ([]Book, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return LoadBooksDir(path)
+	}
+	if filepath.Ext(path) == ".json" {
+		return LoadBooksJSON(path)
+	}
+	id := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return []Book{{ID: id, Title: id, Path: path}}, nil
+}
+
+func sortBooks(books []Book) {
+	sort.Slice(books, func(i, j int) bool {
+		if books[i].Title != books[j].Title {
+			return books[i].Title < books[j].Title
+		}
+		return books[i].ID < books[j].ID
+	})
+}
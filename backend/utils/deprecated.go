@@ -0,0 +1,36 @@
+package utils
+
+import "bufio"
+
+// MatchAllText returns every line of the scanner containing a match of the
+// DFA, with all its occurrences and its context lines. number_matches
+// counts occurrences, not lines.
+//
+// Deprecated: use Compile and Matcher.Scan, which also read lines longer
+// than the 64 KB of a bufio.Scanner.
+func MatchAllText(DFAStart *DFAState, scanner *bufio.Scanner, mode MatchMode, ignoreAccents bool, context ContextSize) (matched bool, number_matches int, matches []LineMatch) {
+	m := &matcher{engine: regexEngine{start: DFAStart, mode: mode}, ignoreAccents: ignoreAccents, context: context}
+	return m.scanLines(scanner)
+}
+
+// KMPSearch returns every line of the scanner containing the pattern, co
+// being its carry over table. With foldCase, pattern and co are those of
+// the folded pattern, and with ignoreAccents of the pattern without
+// diacritics.
+//
+// Deprecated: use Compile with AlgoKMP and Matcher.Scan.
+func KMPSearch(pattern string, scanner *bufio.Scanner, co []int, foldCase bool, ignoreAccents bool, context ContextSize) (matched bool, number_matches int, matches []LineMatch) {
+	m := &matcher{engine: kmpEngine{pattern: pattern, co: co, foldCase: foldCase}, ignoreAccents: ignoreAccents, context: context}
+	return m.scanLines(scanner)
+}
+
+// scanLines is Scan over the lines of a bufio.Scanner.
+func (m *matcher) scanLines(scanner *bufio.Scanner) (matched bool, number_matches int, matches []LineMatch) {
+	collector := newLineCollector(m.context)
+	line_number := 0
+	for scanner.Scan() {
+		line_number++
+		collector.add(line_number, scanner.Text(), m.FindAll(scanner.Text()))
+	}
+	return collector.count > 0, collector.count, collector.matches
+}
@@ -6,11 +6,23 @@ import (
 	"sort"
 )
 
+// MaxDFAStates bounds the states of a DFA built by NFAToDFA, keeping the
+// time and memory of a compilation reasonable.
+const MaxDFAStates = 10000
+
 type DFAState struct {
 	id     int
 	nfaSet map[*State]struct{} // NDFA states represented by this DFA
-	trans  map[rune]*DFAState
+	trans  []dfaTrans          // sorted by rune, assertion symbols first
 	final  bool
+
+	asserts bool // has transitions on assertion symbols
+}
+
+// dfaTrans is the transition of a DFA state on every rune of a range.
+type dfaTrans struct {
+	runeRange
+	to *DFAState
 }
 
 // DFA is the deterministic automaton.
@@ -19,17 +31,12 @@ type DFA struct {
 	states []*DFAState
 }
 
-var dfaID int
-
-func newDFAState(set map[*State]struct{}, final bool) *DFAState {
-	s := &DFAState{
-		id:     dfaID,
+func newDFAState(id int, set map[*State]struct{}, final bool) *DFAState {
+	return &DFAState{
+		id:     id,
 		nfaSet: set,
-		trans:  make(map[rune]*DFAState),
 		final:  final,
 	}
-	dfaID++
-	return s
 }
 
 // epsilonClosure returns all NFA states reachable from 'set' via ε-transitions.
@@ -60,53 +67,123 @@ func move(set map[*State]struct{}, r rune) map[*State]struct{} {
 		for _, t := range s.trans[r] {
 			out[t] = struct{}{}
 		}
+		if isAssertion(r) {
+			continue
+		}
+		for _, c := range s.classes {
+			if inRanges(c.ranges, r) {
+				out[c.to] = struct{}{}
+			}
+		}
 	}
 	return out
 }
 
-// nfaToDFA determinizes the given NFA into a DFA.
-func NFAToDFA(nfa *NFA) *DFA {
-	dfaID = 0
+// inRanges reports whether r is in the sorted ranges.
+func inRanges(ranges []runeRange, r rune) bool {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi >= r })
+	return i < len(ranges) && ranges[i].lo <= r
+}
+
+// step returns the state reached on rune r, nil when there is none.
+func (s *DFAState) step(r rune) *DFAState {
+	lo, hi := 0, len(s.trans)
+	for lo < hi {
+		m := (lo + hi) / 2
+		switch t := &s.trans[m]; {
+		case r < t.lo:
+			hi = m
+		case r > t.hi:
+			lo = m + 1
+		default:
+			return t.to
+		}
+	}
+	return nil
+}
+
+// addTrans appends a transition on [lo, hi], merged with the previous one
+// when they are adjacent and lead to the same state.
+func (s *DFAState) addTrans(lo, hi rune, to *DFAState) {
+	if n := len(s.trans); n > 0 && s.trans[n-1].hi+1 == lo && s.trans[n-1].to == to && !isAssertion(lo) {
+		s.trans[n-1].hi = hi
+		return
+	}
+	s.trans = append(s.trans, dfaTrans{runeRange{lo, hi}, to})
+}
+
+// NFAToDFA determinizes the given NFA into a DFA. DFA states are numbered
+// in order of creation, and keyed by the ids of their NFA states, so the
+// NFA must come from one BuildNFA. Several NFAs can be determinized at the
+// same time. Determinization may need exponentially many states, like for
+// (a|b)*a(a|b){20} : it fails past MaxDFAStates.
+func NFAToDFA(nfa *NFA) (*DFA, error) {
 	startSet := epsilonClosure(map[*State]struct{}{nfa.start: {}})
 	startFinal := containsAccepting(startSet, nfa.accept)
-	startDFA := newDFAState(startSet, startFinal)
+	startDFA := newDFAState(0, startSet, startFinal)
 
 	dfa := &DFA{Start: startDFA, states: []*DFAState{startDFA}}
 
 	unmarked := []*DFAState{startDFA}
 	seen := map[string]*DFAState{keyForSet(startSet): startDFA}
 
+	// stateFor returns the DFA state of an ε-closed set of NFA states
+	stateFor := func(closure map[*State]struct{}) *DFAState {
+		k := keyForSet(closure)
+		next, ok := seen[k]
+		if !ok {
+			next = newDFAState(len(dfa.states), closure, containsAccepting(closure, nfa.accept))
+			seen[k] = next
+			dfa.states = append(dfa.states, next)
+			unmarked = append(unmarked, next)
+		}
+		return next
+	}
+
 	for len(unmarked) > 0 {
+		if len(dfa.states) > MaxDFAStates {
+			return nil, fmt.Errorf("pattern too complex : its DFA has more than %d states", MaxDFAStates)
+		}
 		cur := unmarked[0]
 		unmarked = unmarked[1:]
 
-		// collect all symbols from NFA states in cur
-		symbols := make(map[rune]struct{})
+		// the runes between two consecutive bounds lead to the same NFA
+		// states, so ranges are determinized without listing their runes
+		bounds := map[rune]struct{}{0: {}}
 		for s := range cur.nfaSet {
 			for r := range s.trans {
-				symbols[r] = struct{}{}
+				bounds[r], bounds[r+1] = struct{}{}, struct{}{}
+			}
+			for _, c := range s.classes {
+				for _, rr := range c.ranges {
+					bounds[rr.lo], bounds[rr.hi+1] = struct{}{}, struct{}{}
+				}
 			}
 		}
+		cuts := make([]rune, 0, len(bounds))
+		for r := range bounds {
+			cuts = append(cuts, r)
+		}
+		sort.Slice(cuts, func(i, j int) bool { return cuts[i] < cuts[j] })
 
-		for r := range symbols {
-			m := move(cur.nfaSet, r)
+		for k := 0; k+1 < len(cuts); k++ {
+			lo, hi := cuts[k], cuts[k+1]-1
+			m := move(cur.nfaSet, lo)
 			if len(m) == 0 {
 				continue
 			}
-			closure := epsilonClosure(m)
-			k := keyForSet(closure)
-			next, ok := seen[k]
-			if !ok {
-				next = newDFAState(closure, containsAccepting(closure, nfa.accept))
-				seen[k] = next
-				dfa.states = append(dfa.states, next)
-				unmarked = append(unmarked, next)
+			if isAssertion(lo) {
+				// states without a transition on an assertion stay active
+				for s := range cur.nfaSet {
+					m[s] = struct{}{}
+				}
+				cur.asserts = true
 			}
-			cur.trans[r] = next
+			cur.addTrans(lo, hi, stateFor(epsilonClosure(m)))
 		}
 	}
 
-	return dfa
+	return dfa, nil
 }
 
 // helper: returns true if the NFA accept state is in set
@@ -149,23 +226,43 @@ func (d *DFA) ToDOT(filename string) error {
 			shape = "doublecircle"
 		}
 		out += fmt.Sprintf("  %d [shape=%s];\n", st.id, shape)
-		for r, t := range st.trans {
-			out += fmt.Sprintf("  %d -> %d [label=\"%c\"];\n", st.id, t.id, r)
-		}
+		out += transDOT(st)
 	}
 	out += "}\n"
 	return os.WriteFile(filename, []byte(out), 0644)
 }
 
-// Accept checks whether the DFA accepts a string.
+// transDOT writes the transitions of a state, one edge per target state.
+func transDOT(st *DFAState) string {
+	out := ""
+	targets := []*DFAState{}
+	ranges := map[*DFAState][]runeRange{}
+	for _, t := range st.trans {
+		if isAssertion(t.lo) {
+			out += fmt.Sprintf("  %d -> %d [label=\"%s\"];\n", st.id, t.to.id, symbolLabel(t.lo))
+			continue
+		}
+		if _, ok := ranges[t.to]; !ok {
+			targets = append(targets, t.to)
+		}
+		ranges[t.to] = append(ranges[t.to], t.runeRange)
+	}
+	for _, to := range targets {
+		out += fmt.Sprintf("  %d -> %d [label=\"%s\"];\n", st.id, to.id, rangesLabel(ranges[to]))
+	}
+	return out
+}
+
+// Accept checks whether the DFA accepts a string, taken as a whole line.
 func (d *DFA) Accept(input string) bool {
+	runes := []rune(input)
 	cur := d.Start
-	for _, r := range input {
-		nxt, ok := cur.trans[r]
-		if !ok {
+	for i, r := range runes {
+		cur = cur.assertAt(runes, i)
+		cur = cur.step(r)
+		if cur == nil {
 			return false
 		}
-		cur = nxt
 	}
-	return cur.final
+	return cur.assertAt(runes, len(runes)).final
 }
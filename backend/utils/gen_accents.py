@@ -0,0 +1,30 @@
+# Generates accents_table.go from the Unicode decomposition data of Python's
+# unicodedata : every rune whose canonical decomposition (NFD) is one base
+# rune followed by combining marks (Mn) is mapped to that base rune.
+# Run with `go generate` in backend/utils.
+import sys
+import unicodedata
+
+entries = []
+for cp in range(sys.maxunicode + 1):
+    ch = chr(cp)
+    decomposed = unicodedata.normalize('NFD', ch)
+    if decomposed == ch:
+        continue
+    base = ''.join(c for c in decomposed if unicodedata.category(c) != 'Mn')
+    # runes decomposing into several base runes, like Hangul syllables,
+    # are kept so that every rune of a stripped line stands for one rune
+    if len(base) != 1:
+        continue
+    entries.append((cp, ord(base), unicodedata.name(ch, '')))
+
+with open('accents_table.go', 'w', encoding='utf-8') as f:
+    f.write('// Code generated by gen_accents.py from the Unicode %s decomposition data. DO NOT EDIT.\n\n'
+            % unicodedata.unidata_version)
+    f.write('package utils\n\n')
+    f.write('// accentBase maps the runes whose canonical decomposition is a base rune\n')
+    f.write('// followed by combining marks to that base rune, e.g. é to e.\n')
+    f.write('var accentBase = map[rune]rune{\n')
+    for cp, base, name in entries:
+        f.write('\t0x%04X: 0x%04X, // %s\n' % (cp, base, name))
+    f.write('}\n')
@@ -0,0 +1,237 @@
+package utils
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// JaccardThreshold is the minimal Jaccard similarity between the word
+// sets of two books for them to be linked in the graph.
+const JaccardThreshold = 0.25
+
+// Neighbor is an edge of the similarity graph.
+type Neighbor struct {
+	Book       string
+	Similarity float64 // Jaccard similarity, the edge length is 1 - Similarity
+}
+
+// Graph links books whose vocabularies are similar.
+type Graph struct {
+	Books []string              // sorted ids
+	Edges map[string][]Neighbor // most similar first
+}
+
+// bookTerms returns the sorted term ids used by every indexed book.
+func (idx *Index) bookTerms() map[string][]int {
+	terms := make([]string, 0, len(idx.Postings))
+	for term := range idx.Postings {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	sets := make(map[string][]int, len(idx.Books))
+	for id, term := range terms {
+		for _, book := range idx.Postings[term] {
+			sets[book] = append(sets[book], id)
+		}
+	}
+	return sets
+}
+
+// jaccard returns |a ∩ b| / |a ∪ b| for two sorted sets.
+func jaccard(a, b []int) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	inter := 0
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			inter++
+			i++
+			j++
+		case a[i] < b[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return float64(inter) / float64(len(a)+len(b)-inter)
+}
+
+// JaccardGraph builds the similarity graph of the indexed books, linking
+// two books when the Jaccard similarity of their word sets reaches
+// threshold.
+func JaccardGraph(idx *Index, threshold float64) *Graph {
+	sets := idx.bookTerms()
+	g := &Graph{Edges: make(map[string][]Neighbor, len(idx.Books))}
+	for book := range idx.Books {
+		g.Books = append(g.Books, book)
+	}
+	sort.Strings(g.Books)
+
+	for i, a := range g.Books {
+		for _, b := range g.Books[i+1:] {
+			sim := jaccard(sets[a], sets[b])
+			if sim < threshold {
+				continue
+			}
+			g.Edges[a] = append(g.Edges[a], Neighbor{Book: b, Similarity: sim})
+			g.Edges[b] = append(g.Edges[b], Neighbor{Book: a, Similarity: sim})
+		}
+	}
+	for _, edges := range g.Edges {
+		sort.Slice(edges, func(i, j int) bool {
+			if edges[i].Similarity != edges[j].Similarity {
+				return edges[i].Similarity > edges[j].Similarity
+			}
+			return edges[i].Book < edges[j].Book
+		})
+	}
+	return g
+}
+
+// Suggest returns up to k books related to the given ones (typically the
+// books matching a search) that are not among them. Neighbors are scored by
+// their summed similarity to the given books.
+func (g *Graph) Suggest(books []string, k int) []Neighbor {
+	given := make(map[string]struct{}, len(books))
+	for _, book := range books {
+		given[book] = struct{}{}
+	}
+	scores := map[string]float64{}
+	for _, book := range books {
+		for _, e := range g.Edges[book] {
+			if _, in := given[e.Book]; !in {
+				scores[e.Book] += e.Similarity
+			}
+		}
+	}
+
+	suggestions := make([]Neighbor, 0, len(scores))
+	for book, score := range scores {
+		suggestions = append(suggestions, Neighbor{Book: book, Similarity: score})
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Similarity != suggestions[j].Similarity {
+			return suggestions[i].Similarity > suggestions[j].Similarity
+		}
+		return suggestions[i].Book < suggestions[j].Book
+	})
+	if len(suggestions) > k {
+		suggestions = suggestions[:k]
+	}
+	return suggestions
+}
+
+// PageRank returns the weighted PageRank of every book, edges being
+// weighted by similarity.
+func (g *Graph) PageRank() map[string]float64 {
+	const damping = 0.85
+	n := float64(len(g.Books))
+	rank := make(map[string]float64, len(g.Books))
+	if n == 0 {
+		return rank
+	}
+	weight := make(map[string]float64, len(g.Books)) // sum of outgoing similarities
+	for _, book := range g.Books {
+		rank[book] = 1 / n
+		for _, e := range g.Edges[book] {
+			weight[book] += e.Similarity
+		}
+	}
+
+	for iter := 0; iter < 100; iter++ {
+		// books without edges spread their rank over every book
+		dangling := 0.0
+		for _, book := range g.Books {
+			if weight[book] == 0 {
+				dangling += rank[book]
+			}
+		}
+		next := make(map[string]float64, len(g.Books))
+		for _, book := range g.Books {
+			next[book] = (1-damping)/n + damping*dangling/n
+		}
+		for _, book := range g.Books {
+			for _, e := range g.Edges[book] {
+				next[e.Book] += damping * rank[book] * e.Similarity / weight[book]
+			}
+		}
+
+		delta := 0.0
+		for _, book := range g.Books {
+			delta += math.Abs(next[book] - rank[book])
+		}
+		rank = next
+		if delta < 1e-9 {
+			break
+		}
+	}
+	return rank
+}
+
+// Closeness returns the closeness centrality of every book, using
+// 1 - similarity as edge length. Books reaching few others are penalized
+// (Wasserman and Faust normalization) so disconnected graphs are handled.
+func (g *Graph) Closeness() map[string]float64 {
+	closeness := make(map[string]float64, len(g.Books))
+	n := len(g.Books)
+	for _, book := range g.Books {
+		dist := g.shortestPaths(book)
+		reached, total := 0, 0.0
+		for other, d := range dist {
+			if other != book {
+				reached++
+				total += d
+			}
+		}
+		if total == 0 || n < 2 {
+			closeness[book] = 0
+			continue
+		}
+		closeness[book] = float64(reached) / total * float64(reached) / float64(n-1)
+	}
+	return closeness
+}
+
+// shortestPaths runs Dijkstra from source.
+func (g *Graph) shortestPaths(source string) map[string]float64 {
+	dist := map[string]float64{source: 0}
+	queue := &distQueue{{book: source}}
+	for queue.Len() > 0 {
+		cur := heap.Pop(queue).(distItem)
+		if cur.dist > dist[cur.book] {
+			continue
+		}
+		for _, e := range g.Edges[cur.book] {
+			d := cur.dist + 1 - e.Similarity
+			if old, ok := dist[e.Book]; !ok || d < old {
+				dist[e.Book] = d
+				heap.Push(queue, distItem{book: e.Book, dist: d})
+			}
+		}
+	}
+	return dist
+}
+
+type distItem struct {
+	book string
+	dist float64
+}
+
+// distQueue is a min-heap of distItem for Dijkstra.
+type distQueue []distItem
+
+func (q distQueue) Len() int           { return len(q) }
+func (q distQueue) Less(i, j int) bool { return q[i].dist < q[j].dist }
+func (q distQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *distQueue) Push(x any)        { *q = append(*q, x.(distItem)) }
+func (q *distQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
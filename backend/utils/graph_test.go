@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Word sets : A-B 3/5, C-D 2/5, A-D and B-D 2/6, E shares no word.
+func graphBooks() []Book {
+	return []Book{
+		{ID: "A", Text: "a b c d"},
+		{ID: "B", Text: "a b c e"},
+		{ID: "C", Text: "x y z"},
+		{ID: "D", Text: "a b x y"},
+		{ID: "E", Text: "lonely"},
+	}
+}
+
+// sameNeighbors compares similarities up to rounding errors.
+func sameNeighbors(a, b []Neighbor) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Book != b[i].Book || math.Abs(a[i].Similarity-b[i].Similarity) > 1e-9 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		a, b []int
+		want float64
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 1},
+		{[]int{1, 2, 3}, []int{4, 5}, 0},
+		{[]int{1, 2, 3, 4}, []int{1, 2, 3, 5}, 0.6},
+		{[]int{1}, nil, 0},
+		{nil, nil, 0},
+	}
+	for _, test := range tests {
+		if got := jaccard(test.a, test.b); got != test.want {
+			t.Errorf("jaccard(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestJaccardGraph(t *testing.T) {
+	idx, err := BuildIndex(graphBooks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := JaccardGraph(idx, JaccardThreshold)
+	if !reflect.DeepEqual(g.Books, []string{"A", "B", "C", "D", "E"}) {
+		t.Errorf("books = %q", g.Books)
+	}
+	want := map[string][]Neighbor{
+		"A": {{"B", 0.6}, {"D", 2.0 / 6}},
+		"B": {{"A", 0.6}, {"D", 2.0 / 6}},
+		"C": {{"D", 0.4}},
+		"D": {{"C", 0.4}, {"A", 2.0 / 6}, {"B", 2.0 / 6}},
+	}
+	if !reflect.DeepEqual(g.Edges, want) {
+		t.Errorf("edges = %v, want %v", g.Edges, want)
+	}
+
+	if g := JaccardGraph(idx, 0.5); len(g.Edges) != 2 || len(g.Edges["A"]) != 1 {
+		t.Errorf("edges above 0.5 = %v, want A-B only", g.Edges)
+	}
+	if !reflect.DeepEqual(idx.Similar, g) {
+		t.Error("the index keeps another graph than JaccardGraph(idx, JaccardThreshold)")
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	idx, err := BuildIndex(graphBooks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		books []string
+		k     int
+		want  []Neighbor
+	}{
+		{[]string{"A"}, 5, []Neighbor{{"B", 0.6}, {"D", 2.0 / 6}}},
+		{[]string{"A"}, 1, []Neighbor{{"B", 0.6}}},
+		{[]string{"A", "B"}, 5, []Neighbor{{"D", 4.0 / 6}}}, // summed, given books left out
+		{[]string{"C", "A"}, 5, []Neighbor{{"D", 0.4 + 2.0/6}, {"B", 0.6}}},
+		{[]string{"E"}, 5, []Neighbor{}},
+		{nil, 5, []Neighbor{}},
+	}
+	for _, test := range tests {
+		if got := idx.Similar.Suggest(test.books, test.k); !sameNeighbors(got, test.want) {
+			t.Errorf("Suggest(%q, %d) = %v, want %v", test.books, test.k, got, test.want)
+		}
+	}
+}
+
+func TestCentrality(t *testing.T) {
+	idx, err := BuildIndex(graphBooks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rank := idx.PageRank()
+	total := 0.0
+	for _, book := range idx.Similar.Books {
+		total += rank[book]
+		if book != "D" && rank[book] >= rank["D"] {
+			t.Errorf("PageRank of %s = %v, not below D's %v", book, rank[book], rank["D"])
+		}
+	}
+	if math.Abs(total-1) > 1e-6 {
+		t.Errorf("PageRank sums to %v, want 1", total)
+	}
+
+	closeness := idx.Closeness()
+	if closeness["E"] != 0 {
+		t.Errorf("closeness of the isolated E = %v, want 0", closeness["E"])
+	}
+	for _, book := range []string{"A", "B", "C"} {
+		if closeness[book] >= closeness["D"] {
+			t.Errorf("closeness of %s = %v, not below D's %v", book, closeness[book], closeness["D"])
+		}
+	}
+	if empty := (&Graph{}); len(empty.PageRank()) != 0 || len(empty.Closeness()) != 0 {
+		t.Error("an empty graph has centrality scores")
+	}
+}
@@ -0,0 +1,211 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// IndexedBook keeps what the index knows about a book. Size and ModTime
+// tell whether the book changed since it was indexed, see Book.Stamp.
+type IndexedBook struct {
+	Title   string
+	Lines   int
+	Size    int64
+	ModTime time.Time
+}
+
+// Index is an inverted index of the corpus : term -> books.
+type Index struct {
+	Books    map[string]IndexedBook
+	Postings map[string][]string // ids of the books using the term, sorted
+	Similar  *Graph              // Jaccard similarity graph of the books
+
+	graphOnce     sync.Once
+	pageRankOnce  sync.Once
+	closenessOnce sync.Once
+	pageRank      map[string]float64 // see PageRank
+	closeness     map[string]float64 // see Closeness
+}
+
+// Tokenize splits a line into lower case terms made of letters and digits.
+func Tokenize(line string) []string {
+	words := strings.FieldsFunc(line, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return words
+}
+
+// BuildIndex tokenizes every book once and returns the inverted index.
+func BuildIndex(books []Book) (*Index, error) {
+	idx := &Index{
+		Books:    make(map[string]IndexedBook, len(books)),
+		Postings: make(map[string][]string),
+	}
+
+	sorted := append([]Book{}, books...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	for _, book := range sorted {
+		if _, ok := idx.Books[book.ID]; ok {
+			return nil, fmt.Errorf("book id %s is used twice", book.ID)
+		}
+		size, modTime, err := book.Stamp()
+		if err != nil {
+			return nil, err
+		}
+		r, err := book.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		terms := map[string]struct{}{}
+		indexed := IndexedBook{Title: book.Title, Size: size, ModTime: modTime}
+		err = readLines(r, func(line string) {
+			indexed.Lines++
+			for _, term := range Tokenize(line) {
+				terms[term] = struct{}{}
+			}
+		})
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("book %s: %w", book.ID, err)
+		}
+
+		for term := range terms {
+			idx.Postings[term] = append(idx.Postings[term], book.ID)
+		}
+		idx.Books[book.ID] = indexed
+	}
+	idx.Similar = JaccardGraph(idx, JaccardThreshold)
+	return idx, nil
+}
+
+// Save writes the index to path (gob encoded).
+func (idx *Index) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := gob.NewEncoder(w).Encode(idx); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadIndex reads an index written by Save.
+func LoadIndex(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	idx := &Index{}
+	if err := gob.NewDecoder(bufio.NewReader(f)).Decode(idx); err != nil {
+		return nil, fmt.Errorf("%s: %w, rebuild the index", path, err)
+	}
+	return idx, nil
+}
+
+// DefaultIndexPath is where the index of a corpus (see LoadCorpus) is stored :
+// inside the books directory, or next to books.json or the text file.
+func DefaultIndexPath(corpus string) string {
+	if info, err := os.Stat(corpus); err == nil && info.IsDir() {
+		return filepath.Join(corpus, "index.gob")
+	}
+	return filepath.Join(filepath.Dir(corpus), "index.gob")
+}
+
+// Graph returns the Jaccard similarity graph of the indexed books. It is
+// built by BuildIndex, and on first use for indexes saved without it.
+func (idx *Index) Graph() *Graph {
+	idx.graphOnce.Do(func() {
+		if idx.Similar == nil {
+			idx.Similar = JaccardGraph(idx, JaccardThreshold)
+		}
+	})
+	return idx.Similar
+}
+
+// PageRank returns the PageRank of the books in the similarity graph. It
+// does not depend on the query, so it is computed once, on first use.
+func (idx *Index) PageRank() map[string]float64 {
+	idx.pageRankOnce.Do(func() {
+		idx.pageRank = idx.Graph().PageRank()
+	})
+	return idx.pageRank
+}
+
+// Closeness returns the closeness centrality of the books in the similarity
+// graph, computed once like PageRank : it runs Dijkstra from every book.
+func (idx *Index) Closeness() map[string]float64 {
+	idx.closenessOnce.Do(func() {
+		idx.closeness = idx.Graph().Closeness()
+	})
+	return idx.closeness
+}
+
+// Covers reports whether the book was indexed and did not change since,
+// comparing its size and modification time. Books which are not covered
+// have to be scanned.
+func (idx *Index) Covers(book Book) bool {
+	indexed, ok := idx.Books[book.ID]
+	if !ok {
+		return false
+	}
+	size, modTime, err := book.Stamp()
+	return err == nil && size == indexed.Size && modTime.Equal(indexed.ModTime)
+}
+
+// CandidateBooks returns the indexed books that may contain all the given
+// literal fragments. A fragment may appear anywhere inside a word, so it is
+// split into terms and every term is looked up as a substring of the
+// vocabulary. ok is false when the fragments contain no term at all, in
+// which case every book is a candidate. With ignoreAccents, the fragments
+// have no diacritics and are looked up in the vocabulary without them.
+func (idx *Index) CandidateBooks(fragments []string, ignoreAccents bool) (books map[string]struct{}, ok bool) {
+	for _, fragment := range fragments {
+		for _, term := range Tokenize(fragment) {
+			found := map[string]struct{}{}
+			for word, postings := range idx.Postings {
+				if ignoreAccents {
+					word, _ = StripAccents(word)
+				}
+				if !strings.Contains(word, term) {
+					continue
+				}
+				for _, book := range postings {
+					found[book] = struct{}{}
+				}
+			}
+
+			if !ok {
+				books, ok = found, true
+				continue
+			}
+			for book := range books {
+				if _, in := found[book]; !in {
+					delete(books, book)
+				}
+			}
+		}
+	}
+	return books, ok
+}
@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func testBooks() []Book {
+	return []Book{
+		{ID: "1", Title: "Sea", Text: "The whale swims.\nCall me Ishmael.\n"},
+		{ID: "2", Title: "Town", Text: "Le café de la gare.\nThe town sleeps."},
+		{ID: "3", Title: "Farm", Text: "A whale of a farm,\nthe horses sleep.\n"},
+	}
+}
+
+func keys(set map[string]struct{}) []string {
+	ids := []string{}
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestTokenize(t *testing.T) {
+	got := Tokenize("Call me Ishmael, l'été 1851 !")
+	want := []string{"call", "me", "ishmael", "l", "été", "1851"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokenize = %q, want %q", got, want)
+	}
+}
+
+func TestBuildIndex(t *testing.T) {
+	idx, err := BuildIndex(testBooks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := idx.Postings["whale"]; !reflect.DeepEqual(got, []string{"1", "3"}) {
+		t.Errorf("postings of whale = %q, want [1 3]", got)
+	}
+	if got := idx.Books["2"]; got.Title != "Town" || got.Lines != 2 || got.Size != int64(len(testBooks()[1].Text)) {
+		t.Errorf("book 2 = %+v", got)
+	}
+
+	books := append(testBooks(), Book{ID: "1", Text: "again"})
+	if _, err := BuildIndex(books); err == nil {
+		t.Error("BuildIndex accepted a book id used twice")
+	}
+}
+
+func TestCandidateBooks(t *testing.T) {
+	idx, err := BuildIndex(testBooks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		fragments     []string
+		ignoreAccents bool
+		want          []string
+		ok            bool
+	}{
+		{[]string{"whale"}, false, []string{"1", "3"}, true},
+		{[]string{"hal"}, false, []string{"1", "3"}, true},       // inside a word
+		{[]string{"whale", "sleep"}, false, []string{"3"}, true}, // every fragment
+		{[]string{"the whale"}, false, []string{"1", "3"}, true}, // every term
+		{[]string{"zebra"}, false, []string{}, true},
+		{[]string{"café"}, false, []string{"2"}, true},
+		{[]string{"cafe"}, false, []string{}, true},
+		{[]string{"cafe"}, true, []string{"2"}, true},
+		{[]string{", "}, false, nil, false},
+		{nil, false, nil, false},
+	}
+	for _, test := range tests {
+		books, ok := idx.CandidateBooks(test.fragments, test.ignoreAccents)
+		if ok != test.ok {
+			t.Errorf("CandidateBooks(%q, %v) ok = %v, want %v", test.fragments, test.ignoreAccents, ok, test.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got := keys(books); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("CandidateBooks(%q, %v) = %q, want %q", test.fragments, test.ignoreAccents, got, test.want)
+		}
+	}
+}
+
+func TestCovers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "4.txt")
+	if err := os.WriteFile(path, []byte("A new book.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	books := append(testBooks(), Book{ID: "4", Path: path})
+	idx, err := BuildIndex(books)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, book := range books {
+		if !idx.Covers(book) {
+			t.Errorf("book %s is not covered right after indexing", book.ID)
+		}
+	}
+
+	if idx.Covers(Book{ID: "5", Text: "unknown"}) {
+		t.Error("a book missing from the index is covered")
+	}
+	if idx.Covers(Book{ID: "1", Text: "The whale swims away.\n"}) {
+		t.Error("an embedded text of another size is covered")
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if idx.Covers(books[3]) {
+		t.Error("a file modified since indexing is covered")
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if idx.Covers(books[3]) {
+		t.Error("a removed file is covered")
+	}
+}
+
+func TestSaveLoadIndex(t *testing.T) {
+	idx, err := BuildIndex(testBooks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "index.gob")
+	if err := idx.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded.Books, idx.Books) || !reflect.DeepEqual(loaded.Postings, idx.Postings) {
+		t.Error("the loaded index differs from the saved one")
+	}
+}
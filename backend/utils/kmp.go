@@ -1,8 +1,7 @@
 package utils
 
-import "bufio"
-
-func CreateCarryOverTable(f string) []int {
+func CreateCarryOverTable(pattern string) []int {
+	f := []rune(pattern)
 	n := len(f)
 	co := make([]int, n+1)
 
@@ -31,7 +30,7 @@ func CreateCarryOverTable(f string) []int {
 	return co
 }
 
-func detectLongestPrefixSuffix(pattern string) int {
+func detectLongestPrefixSuffix(pattern []rune) int {
 	n := len(pattern)
 	if n == 0 {
 		return 0
@@ -51,9 +50,30 @@ func detectLongestPrefixSuffix(pattern string) int {
 	return pi[n-1]
 }
 
-func kmpSearchSingleLine(pattern string, text string, co []int) bool {
+// FoldCase maps every rune of s to the same rune as its other cases, e.g.
+// "Sargon" and "SARGON" give the same string. Runes are mapped one to one,
+// so offsets in the folded string are offsets in s.
+func FoldCase(s string) string {
+	return string(foldRunes([]rune(s)))
+}
+
+func foldRunes(runes []rune) []rune {
+	for i, r := range runes {
+		runes[i] = foldRune(r)
+	}
+	return runes
+}
+
+// kmpFindAll returns the non-overlapping occurrences of pattern in text.
+// With foldCase, pattern must already be folded (see FoldCase) and the
+// text is folded before searching.
+func kmpFindAll(pattern string, text string, co []int, foldCase bool) []Match {
 	runePattern := []rune(pattern)
 	runeText := []rune(text)
+	if foldCase {
+		runeText = foldRunes(runeText)
+	}
+	matches := []Match{}
 
 	i := 0 // index for text
 	j := 0 // index for pattern
@@ -62,8 +82,9 @@ func kmpSearchSingleLine(pattern string, text string, co []int) bool {
 		if runeText[i] == runePattern[j] {
 			i++
 			j++
-			if j == len(runePattern) {
-				return true // found a match
+			if j == len(runePattern) { // found a match, restart after it
+				matches = append(matches, Match{Start: i - j, End: i})
+				j = 0
 			}
 		} else {
 			if co[j] == -1 { // -1 on avance dans le texte mais on ressaye avec le pattern de 0
@@ -74,21 +95,5 @@ func kmpSearchSingleLine(pattern string, text string, co []int) bool {
 			}
 		}
 	}
-	return false
-}
-
-func KMPSearch(pattern string, scanner *bufio.Scanner, co []int) (matched bool, number_matches int, matches map[int]string) {
-	number_matches = 0
-	matches = map[int]string{}
-	line_number := 0
-
-	for scanner.Scan() {
-		line_number++
-		line := scanner.Text()
-		if kmpSearchSingleLine(pattern, line, co) {
-			matches[line_number] = line
-			number_matches++
-		}
-	}
-	return number_matches > 0, number_matches, matches
+	return matches
 }
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// readLines calls fn on every line of r, without its "\n" or "\r\n", and
+// returns the first read error. Unlike bufio.Scanner, whose lines are at
+// most 64 KB, lines may be of any length : badly wrapped texts often hold a
+// whole paragraph or chapter on one line. A last line without '\n' is read
+// too.
+func readLines(r io.Reader, fn func(line string)) error {
+	reader := bufio.NewReaderSize(r, 64<<10)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			line = strings.TrimSuffix(line, "\n")
+			fn(strings.TrimSuffix(line, "\r"))
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
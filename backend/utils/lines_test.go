@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReadLines(t *testing.T) {
+	long := strings.Repeat("x", 200<<10)
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", []string{}},
+		{"a\nb\n", []string{"a", "b"}},
+		{"a\nb", []string{"a", "b"}}, // last line without '\n'
+		{"a\r\nb\r\n", []string{"a", "b"}},
+		{"\n\n", []string{"", ""}},
+		{"a\r", []string{"a"}},
+		{long + "\nb", []string{long, "b"}}, // longer than a bufio.Scanner line
+	}
+	for _, test := range tests {
+		got := []string{}
+		err := readLines(strings.NewReader(test.text), func(line string) {
+			got = append(got, line)
+		})
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("readLines(%.20q) = %.40q %v, want %.40q", test.text, got, err, test.want)
+		}
+	}
+}
+
+func TestScanLongLine(t *testing.T) {
+	line := strings.Repeat("é", 1<<20) + "Sargon"
+	m, err := Compile("Sargon", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	number_matches, matches, lines, err := m.Scan(strings.NewReader("a\n" + line + "\nb"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number_matches != 1 || lines != 3 || matches[0].Line != 2 || matches[0].Matches[0] != (Match{Start: 1 << 20, End: 1<<20 + 6}) {
+		t.Errorf("got %d matches in %d lines, first %v", number_matches, lines, matches[0].Matches)
+	}
+}
+
+// errReaderAt fails when reading past limit.
+type errReaderAt struct {
+	r     io.ReaderAt
+	limit int64
+	err   error
+}
+
+func (e errReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off+int64(len(p)) > e.limit {
+		return 0, e.err
+	}
+	return e.r.ReadAt(p, off)
+}
+
+func TestScanReadError(t *testing.T) {
+	fail := errors.New("disk failure")
+	m, err := Compile("Sargon", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := io.MultiReader(strings.NewReader("Sargon\n"), iotest.ErrReader(fail))
+	if _, _, _, err := m.Scan(r); !errors.Is(err, fail) {
+		t.Errorf("Scan : got error %v, want %v", err, fail)
+	}
+
+	text := strings.Repeat("Sargon of Akkad\n", 50000)
+	failing := errReaderAt{r: strings.NewReader(text), limit: int64(len(text)) / 2, err: fail}
+	if _, _, _, err := m.ScanAt(failing, int64(len(text)), 4); !errors.Is(err, fail) {
+		t.Errorf("ScanAt : got error %v, want %v", err, fail)
+	}
+}
@@ -0,0 +1,132 @@
+package utils
+
+import "strings"
+
+// literals describes the fixed strings of a regex sub-tree.
+type literals struct {
+	exact    string // the only string matched by the node, when isExact
+	isExact  bool
+	prefix   string   // every match starts with prefix
+	suffix   string   // every match ends with suffix
+	required []string // strings contained in every match of the node
+}
+
+func exactLiterals(s string) literals {
+	return literals{exact: s, isExact: true, prefix: s, suffix: s}
+}
+
+// all returns every string a match of the node must contain.
+func (l literals) all() []string {
+	if l.isExact {
+		return []string{l.exact}
+	}
+	return append([]string{l.prefix, l.suffix}, l.required...)
+}
+
+func nodeLiterals(n *RegexTreeNode) literals {
+	if n == nil {
+		return exactLiterals("")
+	}
+	switch n.operation {
+	case "empty":
+		return exactLiterals("")
+
+	case "atom":
+		return exactLiterals(string(n.value))
+
+	case "charset":
+		if r, ok := n.class.single(); ok {
+			return exactLiterals(string(r))
+		}
+		return literals{}
+
+	case "concat":
+		left, right := nodeLiterals(n.left), nodeLiterals(n.right)
+		if left.isExact && right.isExact {
+			return exactLiterals(left.exact + right.exact)
+		}
+		l := literals{prefix: left.prefix, suffix: right.suffix}
+		if left.isExact {
+			l.prefix = left.exact + right.prefix
+		}
+		if right.isExact {
+			l.suffix = left.suffix + right.exact
+		}
+		// the end of the left side is followed by the start of the right one
+		l.required = append(l.required, left.required...)
+		l.required = append(l.required, right.required...)
+		l.required = append(l.required, left.suffix+right.prefix)
+		return l
+
+	case "or":
+		left, right := nodeLiterals(n.left), nodeLiterals(n.right)
+		if left.isExact && right.isExact && left.exact == right.exact {
+			return left
+		}
+		return literals{
+			prefix: commonPrefix(left.prefix, right.prefix),
+			suffix: commonSuffix(left.suffix, right.suffix),
+		}
+
+	case "repeat":
+		if n.min == 0 {
+			return literals{}
+		}
+		sub := nodeLiterals(n.left)
+		if sub.isExact && n.max == n.min {
+			return exactLiterals(strings.Repeat(sub.exact, n.min))
+		}
+		// X{n,m} with n > 0 contains at least one X
+		return literals{prefix: sub.prefix, suffix: sub.suffix, required: sub.all()}
+
+	case "plus":
+		// X+ contains at least one X
+		sub := nodeLiterals(n.left)
+		return literals{prefix: sub.prefix, suffix: sub.suffix, required: sub.all()}
+	}
+	// star and optional may match the empty string
+	return literals{}
+}
+
+func commonPrefix(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+	i := 0
+	for i < len(ra) && i < len(rb) && ra[i] == rb[i] {
+		i++
+	}
+	return string(ra[:i])
+}
+
+func commonSuffix(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+	i := 0
+	for i < len(ra) && i < len(rb) && ra[len(ra)-1-i] == rb[len(rb)-1-i] {
+		i++
+	}
+	return string(ra[len(ra)-i:])
+}
+
+// RequiredLiterals returns the literal fragments that any match of the
+// regex contains, e.g. "S" and "on" for S((a|r|g)*)on. They are used to
+// shortlist books with the index before running the DFA.
+func (n *RegexTreeNode) RequiredLiterals() []string {
+	all := nodeLiterals(n).all()
+	fragments := []string{}
+	for i, f := range all {
+		if f == "" {
+			continue
+		}
+		// keep the longest fragments only, "Tin" already requires "in"
+		redundant := false
+		for j, other := range all {
+			if i != j && strings.Contains(other, f) && (len(other) > len(f) || j < i) {
+				redundant = true
+				break
+			}
+		}
+		if !redundant {
+			fragments = append(fragments, f)
+		}
+	}
+	return fragments
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRequiredLiterals(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"Sargon", []string{"Sargon"}},
+		{"S((a|r|g)*)on", []string{"S", "on"}},
+		{"(Tin|Tan)tin", []string{"T", "ntin"}},
+		{"the (whale|wharf)", []string{"the wha"}},
+		{"ab+c", []string{"ab", "bc"}},
+		{"(ab){2}", []string{"abab"}},
+		{"(ab){2,3}x", []string{"abx"}},
+		{"a*", []string{}},
+		{"(abc)?", []string{}},
+		{"a|b", []string{}},
+		{"[Ss]ea", []string{"ea"}},
+		{"[s]ea", []string{"sea"}},
+		{".*whale.*", []string{"whale"}},
+	}
+	for _, test := range tests {
+		tree, err := (&RegexTreeNode{}).ParseRegex(test.pattern)
+		if err != nil {
+			t.Fatalf("ParseRegex(%q): %v", test.pattern, err)
+		}
+		if got := tree.RequiredLiterals(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("RequiredLiterals(%q) = %q, want %q", test.pattern, got, test.want)
+		}
+	}
+}
+
+// Every book containing a match must be a candidate of the index.
+func TestCandidatesKeepMatches(t *testing.T) {
+	books := testBooks()
+	idx, err := BuildIndex(books)
+	if err != nil {
+		t.Fatal(err)
+	}
+	patterns := []string{
+		"whale", "hal", "wh(a|i)le", "The (whale|town)", "s+le", "(Ca|ca)f",
+		"caf", "e\\.", "sleep(s)?", "l{2}", "a.*m", "\\bthe\\b", "[Tt]he",
+	}
+	for _, pattern := range patterns {
+		for _, ignoreAccents := range []bool{false, true} {
+			m, err := Compile(pattern, Options{IgnoreAccents: ignoreAccents})
+			if err != nil {
+				t.Fatalf("Compile(%q): %v", pattern, err)
+			}
+			found, ok := idx.CandidateBooks(m.Literals(), ignoreAccents)
+			if !ok {
+				continue
+			}
+			for _, book := range books {
+				if _, in := found[book.ID]; in {
+					continue
+				}
+				for _, line := range strings.Split(book.Text, "\n") {
+					if m.MatchLine(line) {
+						t.Errorf("%q (ignoreAccents %v) matches %q of book %s, which is not a candidate",
+							pattern, ignoreAccents, line, book.ID)
+					}
+				}
+			}
+		}
+	}
+}
@@ -1,42 +1,139 @@
 package utils
 
-import (
-	"bufio"
+import "fmt"
+
+// MatchMode selects which match is reported among the substrings accepted
+// from the same (leftmost) start position.
+type MatchMode int
+
+const (
+	LeftmostLongest  MatchMode = iota // POSIX semantics, the longest substring
+	LeftmostShortest                  // the first final state reached
 )
 
-// MatchInText returns true if the DFA accepts any substring of `text`.
-func matchInText(dfaStart *DFAState, text string) bool {
+// ParseMatchMode reads "longest" or "shortest".
+func ParseMatchMode(mode string) (MatchMode, error) {
+	switch mode {
+	case "longest":
+		return LeftmostLongest, nil
+	case "shortest":
+		return LeftmostShortest, nil
+	}
+	return 0, fmt.Errorf("unknown match mode %q, expected \"longest\" or \"shortest\"", mode)
+}
+
+func (m MatchMode) String() string {
+	if m == LeftmostShortest {
+		return "shortest"
+	}
+	return "longest"
+}
+
+// Match is one occurrence of a pattern in a line, as rune offsets : the
+// matched text is []rune(line)[Start:End].
+type Match struct {
+	Start int
+	End   int
+}
+
+// LineMatch is a matching line with every occurrence found in it.
+type LineMatch struct {
+	Line    int // line number, starting at 1
+	Text    string
+	Matches []Match
+	Before  []ContextLine // context, see ContextSize
+	After   []ContextLine
+}
+
+// thread is a run of the DFA started at a given position of the text.
+type thread struct {
+	state *DFAState
+	start int
+}
+
+// findAllInText returns the non-overlapping substrings of `text` accepted by
+// the DFA, from left to right. Empty substrings are never reported.
+//
+// The text is scanned once, running one DFA thread per start position at
+// the same time. Threads reaching the same state share the same future, so
+// only the leftmost one is kept : a step costs at most one transition per
+// DFA state, instead of restarting the DFA at every position. Only the text
+// scanned past the end of a match (while looking for a longer one) is read
+// again to find the next match.
+func findAllInText(dfaStart *DFAState, text string, mode MatchMode) []Match {
 	runes := []rune(text)
+	matches := []Match{}
 
-	for i := 0; i < len(runes); i++ { // start position
-		state := dfaStart
-		for j := i; j < len(runes); j++ { // extend the substring
-			next, ok := state.trans[runes[j]]
-			if !ok {
-				break // no transition, stop this substring
+	threads := []thread{} // ordered by start position
+	seen := map[*DFAState]struct{}{}
+	best := Match{Start: -1}
+
+	i := 0
+	for {
+		// a new match may start here, unless one already started earlier
+		if best.Start == -1 && i < len(runes) {
+			threads = append(threads, thread{state: dfaStart, start: i})
+		}
+
+		// zero-width assertions holding before runes[i]
+		asserted := false
+		for k, t := range threads {
+			threads[k].state = t.state.assertAt(runes, i)
+			asserted = asserted || threads[k].state != t.state
+		}
+		if asserted {
+			threads = keepThreads(threads, i, mode, &best, seen)
+		}
+
+		if i == len(runes) {
+			if best.Start == -1 {
+				break
+			}
+		} else {
+			for k := range threads {
+				threads[k].state = threads[k].state.step(runes[i])
 			}
-			state = next
-			if state.final {
-				return true // found a substring that matches
+			threads = keepThreads(threads, i+1, mode, &best, seen)
+			i++
+			if best.Start == -1 || len(threads) > 0 {
+				continue
 			}
 		}
+
+		// no thread left to find a more leftmost or longer match
+		matches = append(matches, best)
+		i = best.End
+		best = Match{Start: -1}
+		threads = threads[:0]
 	}
-	return false
+	return matches
 }
 
-func MatchAllText(DFAStart *DFAState, scanner *bufio.Scanner) (matched bool, number_matches int, matches map[int]string) {
-	number_matches = 0
-	matches = map[int]string{}
-	line_number := 0
-
-	for scanner.Scan() {
-		line_number++
-		line := scanner.Text()
-
-		if matchInText(DFAStart, line) {
-			matches[line_number] = line
-			number_matches++
+// keepThreads drops dead threads (nil state) and the ones merged with a
+// thread started earlier, records in best the matches completed at
+// position end, and drops the threads that can no longer improve best.
+// threads is filtered in place.
+func keepThreads(threads []thread, end int, mode MatchMode, best *Match, seen map[*DFAState]struct{}) []thread {
+	clear(seen)
+	kept := threads[:0]
+	for _, t := range threads {
+		if t.state == nil {
+			continue
+		}
+		if _, merged := seen[t.state]; merged {
+			continue
+		}
+		seen[t.state] = struct{}{}
+		if best.Start != -1 && (t.start > best.Start || (t.start == best.Start && mode == LeftmostShortest)) {
+			continue
+		}
+		if t.state.final && end > t.start {
+			*best = Match{Start: t.start, End: end}
+			if mode == LeftmostShortest {
+				continue
+			}
 		}
+		kept = append(kept, t)
 	}
-	return number_matches > 0, number_matches, matches
+	return kept
 }
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bruteFind is findAllInText restarting the DFA at every position.
+func bruteFind(start *DFAState, text string, mode MatchMode) []Match {
+	runes := []rune(text)
+	matches := []Match{}
+	for i := 0; i < len(runes); {
+		end := -1
+		state := start
+		for j := i; state != nil; j++ {
+			state = state.assertAt(runes, j)
+			if state.final && j > i {
+				end = j
+				if mode == LeftmostShortest {
+					break
+				}
+			}
+			if j == len(runes) {
+				break
+			}
+			state = state.step(runes[j])
+		}
+		if end == -1 {
+			i++
+			continue
+		}
+		matches = append(matches, Match{Start: i, End: end})
+		i = end
+	}
+	return matches
+}
+
+// The single pass of findAllInText finds the matches of a restart at every
+// position.
+func TestFindAllInText(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for n := 0; n < 300; n++ {
+		pattern := randomPattern(r, 1+r.Intn(3))
+		for _, mode := range []MatchMode{LeftmostLongest, LeftmostShortest} {
+			m, err := Compile(pattern, Options{Algo: AlgoRegex, Mode: mode})
+			if err != nil {
+				t.Fatalf("Compile(%q) : %v", pattern, err)
+			}
+			start := m.(*matcher).engine.(regexEngine).start
+			for k := 0; k < 30; k++ {
+				line := make([]rune, r.Intn(20))
+				for j := range line {
+					line[j] = []rune("ab cé")[r.Intn(5)]
+				}
+				got, want := findAllInText(start, string(line), mode), bruteFind(start, string(line), mode)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("%q (%v) in %q : got %v, want %v", pattern, mode, string(line), got, want)
+				}
+			}
+		}
+	}
+}
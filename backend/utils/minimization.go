@@ -1,139 +1,139 @@
 package utils
 
+import "sort"
+
 // Minimize returns a new DFA that is equivalent but with the minimal number of states.
 func (d *DFA) Minimize() *DFA {
-	// 1. collect alphabet
+	// 1. collect alphabet : every state treats the runes between two
+	// consecutive range bounds the same way, the first one stands for them
 	alphabet := map[rune]struct{}{}
 	for _, st := range d.states {
-		for r := range st.trans {
-			alphabet[r] = struct{}{}
+		for _, t := range st.trans {
+			alphabet[t.lo], alphabet[t.hi+1] = struct{}{}, struct{}{}
 		}
 	}
 	symbols := []rune{}
 	for r := range alphabet {
 		symbols = append(symbols, r)
 	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i] < symbols[j] })
 
-	// 2. initial partition: final vs non-final
-	final, nonfinal := map[*DFAState]struct{}{}, map[*DFAState]struct{}{}
-	for _, s := range d.states {
+	// 2. initial partition : final vs non-final. Blocks are contiguous
+	// parts of elems, block b being elems[start[b]:end[b]].
+	n := len(d.states)
+	index := make(map[*DFAState]int, n)
+	for i, s := range d.states {
+		index[s] = i
+	}
+	elems := make([]int, 0, n)
+	for i, s := range d.states {
 		if s.final {
-			final[s] = struct{}{}
-		} else {
-			nonfinal[s] = struct{}{}
+			elems = append(elems, i)
 		}
 	}
-	P := []map[*DFAState]struct{}{}
-	if len(final) > 0 {
-		P = append(P, final)
+	finals := len(elems)
+	for i, s := range d.states {
+		if !s.final {
+			elems = append(elems, i)
+		}
 	}
-	if len(nonfinal) > 0 {
-		P = append(P, nonfinal)
+	pos := make([]int, n)   // of every state in elems
+	block := make([]int, n) // of every state
+	start, end := []int{}, []int{}
+	for _, bounds := range [][2]int{{0, finals}, {finals, n}} {
+		if bounds[0] == bounds[1] {
+			continue
+		}
+		for p := bounds[0]; p < bounds[1]; p++ {
+			pos[elems[p]] = p
+			block[elems[p]] = len(start)
+		}
+		start, end = append(start, bounds[0]), append(end, bounds[1])
 	}
 
-	// Hopcroft refinement
-	W := append([]map[*DFAState]struct{}{}, P...)
+	// inverse transitions : sources[c][t] are the states going to t on
+	// symbols[c]
+	sources := make([]map[int][]int, len(symbols))
+	for c, sym := range symbols {
+		sources[c] = map[int][]int{}
+		for i, s := range d.states {
+			if t := s.step(sym); t != nil {
+				sources[c][index[t]] = append(sources[c][index[t]], i)
+			}
+		}
+	}
+
+	// Hopcroft refinement : the states going to the splitter block on a
+	// symbol are moved to the front of their block, which is split when
+	// only some of its states moved. O(n k log n) for k symbols.
+	W := []int{}
+	inW := []bool{}
+	for b := range start {
+		W, inW = append(W, b), append(inW, true)
+	}
+	moved := []int{} // states of each block moved to its front
 	for len(W) > 0 {
 		A := W[len(W)-1]
 		W = W[:len(W)-1]
-		for _, c := range symbols {
-			// X = states whose c-transition goes to A
-			X := map[*DFAState]struct{}{}
-			for _, s := range d.states {
-				if t, ok := s.trans[c]; ok {
-					if _, in := A[t]; in {
-						X[s] = struct{}{}
+		inW[A] = false
+		splitter := append([]int{}, elems[start[A]:end[A]]...)
+		for c := range symbols {
+			touched := []int{}
+			for _, t := range splitter {
+				for _, s := range sources[c][t] {
+					b := block[s]
+					for len(moved) <= b {
+						moved = append(moved, 0)
+					}
+					front := start[b] + moved[b]
+					if pos[s] < front {
+						continue // already moved
+					}
+					if moved[b] == 0 {
+						touched = append(touched, b)
 					}
+					other := elems[front]
+					elems[front], elems[pos[s]] = s, other
+					pos[other], pos[s] = pos[s], front
+					moved[b]++
 				}
 			}
-			newP := []map[*DFAState]struct{}{}
-			for _, Y := range P {
-				// split Y into Y∩X and Y\X
-				iPart := map[*DFAState]struct{}{}
-				dPart := map[*DFAState]struct{}{}
-				for s := range Y {
-					if _, in := X[s]; in {
-						iPart[s] = struct{}{}
-					} else {
-						dPart[s] = struct{}{}
-					}
+			for _, b := range touched {
+				split := start[b] + moved[b]
+				moved[b] = 0
+				if split == end[b] {
+					continue // every state of b moved
 				}
-				if len(iPart) > 0 && len(dPart) > 0 {
-					newP = append(newP, iPart, dPart)
-					// keep sets to refine
-					for idx, Z := range W {
-						if sameSet(Z, Y) {
-							W[idx] = iPart
-							W = append(W, dPart)
-							break
-						}
-					}
-					if !containsSet(W, Y) {
-						if len(iPart) < len(dPart) {
-							W = append(W, iPart)
-						} else {
-							W = append(W, dPart)
-						}
-					}
+				// the moved states become a new block
+				nb := len(start)
+				start, end = append(start, start[b]), append(end, split)
+				start[b] = split
+				for p := start[nb]; p < end[nb]; p++ {
+					block[elems[p]] = nb
+				}
+				inW = append(inW, false)
+				if inW[b] || end[nb]-start[nb] < end[b]-start[b] {
+					W, inW[nb] = append(W, nb), true
 				} else {
-					newP = append(newP, Y)
+					W, inW[b] = append(W, b), true
 				}
 			}
-			P = newP
 		}
 	}
 
-	// 3. Build new DFA states for each partition
-	partMap := map[*DFAState]int{}
-	for i, part := range P {
-		for s := range part {
-			partMap[s] = i
-		}
-	}
-	newStates := make([]*DFAState, len(P))
-	for i, part := range P {
-		// pick a rep to decide final
-		final := false
-		for s := range part {
-			if s.final {
-				final = true
-				break
-			}
-		}
-		newStates[i] = &DFAState{id: i, trans: make(map[rune]*DFAState), final: final}
+	// 3. Build new DFA states for each block
+	newStates := make([]*DFAState, len(start))
+	for i := range newStates {
+		// states of a block are all final or all non-final
+		newStates[i] = &DFAState{id: i, final: d.states[elems[start[i]]].final}
 	}
-	for i, part := range P {
-		rep := first(part)
-		for r, t := range rep.trans {
-			newStates[i].trans[r] = newStates[partMap[t]]
+	for i := range newStates {
+		rep := d.states[elems[start[i]]]
+		newStates[i].asserts = rep.asserts
+		for _, t := range rep.trans {
+			newStates[i].addTrans(t.lo, t.hi, newStates[block[index[t.to]]])
 		}
 	}
 
-	return &DFA{Start: newStates[partMap[d.Start]], states: newStates}
-}
-
-func sameSet(a, b map[*DFAState]struct{}) bool {
-	if len(a) != len(b) {
-		return false
-	}
-	for s := range a {
-		if _, ok := b[s]; !ok {
-			return false
-		}
-	}
-	return true
-}
-func containsSet(list []map[*DFAState]struct{}, target map[*DFAState]struct{}) bool {
-	for _, s := range list {
-		if sameSet(s, target) {
-			return true
-		}
-	}
-	return false
-}
-func first(m map[*DFAState]struct{}) *DFAState {
-	for s := range m {
-		return s
-	}
-	return nil
+	return &DFA{Start: newStates[block[index[d.Start]]], states: newStates}
 }
@@ -3,93 +3,141 @@ package utils
 import (
 	"fmt"
 	"os"
+	"strings"
+	"unicode"
 )
 
 type State struct {
 	id        int
 	epsilon   []*State          // ε-transitions
 	trans     map[rune][]*State // normal transitions on a rune
+	classes   []classTrans      // transitions on ranges of runes
 	accepting bool
 }
 
+// classTrans is a transition on every rune of the ranges, e.g. [a-z] or '.'
+// (every rune except '\n').
+type classTrans struct {
+	ranges []runeRange // normalized, see normalizeRanges
+	to     *State
+}
+
 type NFA struct {
 	start  *State
 	accept *State
 }
 
-var stateID int // counter
+// nfaBuilder holds the options and the state ids of one construction, so
+// that several NFAs can be built at the same time.
+type nfaBuilder struct {
+	foldCase bool // atoms and classes also match the other cases of their runes
+	nextID   int  // id of the next state, ids are unique in the NFA
+}
 
-func newState() *State {
+func (b *nfaBuilder) newState() *State {
 	s := &State{
-		id:      stateID,
+		id:      b.nextID,
 		epsilon: []*State{},
 		trans:   make(map[rune][]*State),
 	}
-	stateID++
+	b.nextID++
 	return s
 }
 
-func BuildNFA(node *RegexTreeNode) *NFA {
+// BuildNFA builds the Thompson NFA of the tree, case-insensitive when
+// foldCase is set.
+func BuildNFA(node *RegexTreeNode, foldCase bool) *NFA {
 	if node == nil {
 		return nil
 	}
-	start, accept := buildState(node)
+	b := &nfaBuilder{foldCase: foldCase}
+	start, accept := b.buildState(node)
 	accept.accepting = true // last return state us accepting
 	return &NFA{start: start, accept: accept}
 }
 
-func buildState(n *RegexTreeNode) (*State, *State) {
+func (b *nfaBuilder) buildState(n *RegexTreeNode) (*State, *State) {
 	switch n.operation {
-	case "atom":
-		s1 := newState()
-		s2 := newState()
-		s1.trans[n.value] = append(s1.trans[n.value], s2)
+	case "atom", "assert":
+		// assertions are transitions on their symbol, see assertions.go
+		s1 := b.newState()
+		s2 := b.newState()
+		symbols := []rune{n.value}
+		if b.foldCase && n.operation == "atom" {
+			symbols = foldOrbit(n.value)
+		}
+		for _, r := range symbols {
+			s1.trans[r] = append(s1.trans[r], s2)
+		}
+		return s1, s2
+
+	case "any":
+		// any rune except newline
+		s1 := b.newState()
+		s2 := b.newState()
+		s1.classes = append(s1.classes, classTrans{ranges: complementRanges([]runeRange{{'\n', '\n'}}), to: s2})
 		return s1, s2
 
 	case "charset":
-		s1 := newState()
-		s2 := newState()
-		// Create transitions for all characters in the character set
-		for _, char := range n.charSet {
-			s1.trans[char] = append(s1.trans[char], s2)
+		s1 := b.newState()
+		s2 := b.newState()
+		ranges := n.class.matched()
+		if b.foldCase {
+			ranges = n.class.foldedMatched()
 		}
+		s1.classes = append(s1.classes, classTrans{ranges: ranges, to: s2})
 		return s1, s2
 
 	case "concat":
-		leftStart, leftAccept := buildState(n.left)
-		rightStart, rightAccept := buildState(n.right)
+		leftStart, leftAccept := b.buildState(n.left)
+		rightStart, rightAccept := b.buildState(n.right)
 		leftAccept.epsilon = append(leftAccept.epsilon, rightStart)
 		return leftStart, rightAccept
 
 	case "or":
-		s := newState()
-		e := newState()
-		lStart, lAccept := buildState(n.left)
-		rStart, rAccept := buildState(n.right)
+		s := b.newState()
+		e := b.newState()
+		lStart, lAccept := b.buildState(n.left)
+		rStart, rAccept := b.buildState(n.right)
 		s.epsilon = append(s.epsilon, lStart, rStart)
 		lAccept.epsilon = append(lAccept.epsilon, e)
 		rAccept.epsilon = append(rAccept.epsilon, e)
 		return s, e
 
 	case "star":
-		s := newState()
-		e := newState()
-		subStart, subAccept := buildState(n.left)
+		s := b.newState()
+		e := b.newState()
+		subStart, subAccept := b.buildState(n.left)
 		s.epsilon = append(s.epsilon, subStart, e)
 		subAccept.epsilon = append(subAccept.epsilon, subStart, e)
 		return s, e
 
 	case "plus":
 		// one or more: X+ = X X*
-		xStart, xAccept := buildState(n.left)
-		loopStart, loopAccept := buildState(&RegexTreeNode{operation: "star", left: n.left})
+		xStart, xAccept := b.buildState(n.left)
+		loopStart, loopAccept := b.buildState(&RegexTreeNode{operation: "star", left: n.left})
 		xAccept.epsilon = append(xAccept.epsilon, loopStart)
 		return xStart, loopAccept
 
+	case "repeat":
+		// X{n,m} = n copies of X followed by m-n optional ones, X{n,} ends
+		// with X*
+		if n.max == 0 {
+			return b.buildState(&RegexTreeNode{operation: "empty"})
+		}
+		return b.buildState(expandRepeat(n))
+
+	case "empty":
+		// matches the empty string, e.g. "a|" or "()"
+		s := b.newState()
+		e := b.newState()
+		s.epsilon = append(s.epsilon, e)
+		return s, e
+
 	case "optional":
-		s := newState()
-		e := newState()
-		subStart, subAccept := buildState(n.left)
+		s := b.newState()
+		e := b.newState()
+		subStart, subAccept := b.buildState(n.left)
 		s.epsilon = append(s.epsilon, subStart, e)
 		subAccept.epsilon = append(subAccept.epsilon, e)
 		return s, e
@@ -97,6 +145,32 @@ func buildState(n *RegexTreeNode) (*State, *State) {
 	return nil, nil
 }
 
+// expandRepeat rewrites a repeat node with concat, optional and star nodes,
+// e.g. X{2,4} is X X (X X?)?.
+func expandRepeat(n *RegexTreeNode) *RegexTreeNode {
+	var tail *RegexTreeNode
+	if n.max == -1 {
+		tail = &RegexTreeNode{operation: "star", left: n.left}
+	} else {
+		for i := n.min; i < n.max; i++ {
+			body := n.left
+			if tail != nil {
+				body = &RegexTreeNode{operation: "concat", left: n.left, right: tail}
+			}
+			tail = &RegexTreeNode{operation: "optional", left: body}
+		}
+	}
+	expanded := tail
+	for i := 0; i < n.min; i++ {
+		if expanded == nil {
+			expanded = n.left
+		} else {
+			expanded = &RegexTreeNode{operation: "concat", left: n.left, right: expanded}
+		}
+	}
+	return expanded
+}
+
 //to DOT
 
 func (nfa *NFA) ToDOT(filename string) error {
@@ -120,13 +194,33 @@ func writeStates(s *State, visited map[int]bool) string {
 	str := ""
 	for r, targets := range s.trans {
 		for _, t := range targets {
-			str += fmt.Sprintf("  %d -> %d [label=\"%c\"];\n", s.id, t.id, r)
+			str += fmt.Sprintf("  %d -> %d [label=\"%s\"];\n", s.id, t.id, symbolLabel(r))
 			str += writeStates(t, visited)
 		}
 	}
+	for _, c := range s.classes {
+		str += fmt.Sprintf("  %d -> %d [label=\"%s\"];\n", s.id, c.to.id, rangesLabel(c.ranges))
+		str += writeStates(c.to, visited)
+	}
 	for _, t := range s.epsilon {
 		str += fmt.Sprintf("  %d -> %d [label=\"ε\"];\n", s.id, t.id)
 		str += writeStates(t, visited)
 	}
 	return str
 }
+
+// rangesLabel shows ranges of runes as a class, negated when they reach
+// the last rune, e.g. '.' or [^0-9].
+func rangesLabel(ranges []runeRange) string {
+	if len(ranges) == 1 && ranges[0].lo == ranges[0].hi {
+		return symbolLabel(ranges[0].lo)
+	}
+	class := charClass{ranges: ranges}
+	if ranges[len(ranges)-1].hi == unicode.MaxRune {
+		class = charClass{ranges: complementRanges(ranges), negated: true}
+		if len(class.ranges) == 1 && class.ranges[0] == (runeRange{'\n', '\n'}) {
+			return "."
+		}
+	}
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(class.String())
+}
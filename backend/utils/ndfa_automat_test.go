@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// nfaIDs returns the sorted ids of the states reachable from the start.
+func nfaIDs(nfa *NFA) []int {
+	seen := map[*State]struct{}{}
+	stack := []*State{nfa.start}
+	ids := []int{}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		ids = append(ids, s.id)
+		stack = append(stack, s.epsilon...)
+		for _, to := range s.trans {
+			stack = append(stack, to...)
+		}
+		for _, c := range s.classes {
+			stack = append(stack, c.to)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// Automata built at the same time get the ids of a construction alone.
+func TestConcurrentBuild(t *testing.T) {
+	patterns := []string{"S((a|r|g)*)on", `\bthe\b`, "[a-z]{2,4}x", "(ab|cd)*e"}
+	type built struct {
+		nfa []int
+		dfa []int
+	}
+	build := func(pattern string) (built, error) {
+		tree, err := (&RegexTreeNode{}).ParseRegex(pattern)
+		if err != nil {
+			return built{}, err
+		}
+		nfa := BuildNFA(tree, false)
+		dfa, err := NFAToDFA(nfa)
+		if err != nil {
+			return built{}, err
+		}
+		b := built{nfa: nfaIDs(nfa)}
+		for _, s := range dfa.states {
+			b.dfa = append(b.dfa, s.id)
+		}
+		return b, nil
+	}
+
+	want := map[string]built{}
+	for _, pattern := range patterns {
+		b, err := build(pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, id := range b.nfa {
+			if id != i {
+				t.Fatalf("%q : NFA ids %v are not 0..%d", pattern, b.nfa, len(b.nfa)-1)
+			}
+		}
+		want[pattern] = b
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 8*len(patterns))
+	for g := 0; g < 8; g++ {
+		for _, pattern := range patterns {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if b, err := build(pattern); err != nil || !reflect.DeepEqual(b, want[pattern]) {
+					errs <- pattern
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for pattern := range errs {
+		t.Errorf("%q : concurrent construction differs from a construction alone", pattern)
+	}
+}
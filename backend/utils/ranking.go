@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Ranking methods accepted by RankBooks.
+const (
+	RankMatches   = "matches"   // number of matches
+	RankTFIDF     = "tfidf"     // matches per line, weighted by rarity in the corpus
+	RankBM25      = "bm25"      // Okapi BM25 with the query as a single term
+	RankPageRank  = "pagerank"  // PageRank in the Jaccard graph of the index
+	RankCloseness = "closeness" // closeness centrality in the Jaccard graph of the index
+)
+
+// RankMethods lists the valid ranking methods.
+var RankMethods = []string{RankMatches, RankTFIDF, RankBM25, RankPageRank, RankCloseness}
+
+// BM25 parameters
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// BookHits is what a search found in one book.
+type BookHits struct {
+	Book    string
+	Matches int
+	Lines   int // length of the book
+}
+
+// RankedBook is a book with its relevance score, higher is better.
+type RankedBook struct {
+	Book    string
+	Score   float64
+	Matches int
+}
+
+// averageLines is the average length of the books of the corpus, from the
+// index when there is one, otherwise of the books that matched.
+func averageLines(hits []BookHits, idx *Index) float64 {
+	total, books := 0, 0
+	if idx != nil && len(idx.Books) > 0 {
+		for _, book := range idx.Books {
+			total += book.Lines
+		}
+		books = len(idx.Books)
+	} else {
+		for _, h := range hits {
+			total += h.Lines
+		}
+		books = len(hits)
+	}
+	return float64(total) / float64(max(books, 1))
+}
+
+// CheckRankMethod returns the error RankBooks would return for method,
+// so that it is reported before searching.
+func CheckRankMethod(method string, idx *Index) error {
+	switch method {
+	case RankMatches, RankTFIDF, RankBM25:
+		return nil
+	case RankPageRank, RankCloseness:
+		if idx == nil {
+			return fmt.Errorf("ranking by %s needs an index, build it first", method)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown ranking method %q, expected one of %v", method, RankMethods)
+}
+
+// RankBooks orders the books that matched a query. The query is treated as
+// a single term whose occurrences are the matches : its document frequency
+// is the number of books with hits among the searched ones. Centrality
+// methods need the index to build the similarity graph.
+func RankBooks(method string, hits []BookHits, searched int, idx *Index) ([]RankedBook, error) {
+	if err := CheckRankMethod(method, idx); err != nil {
+		return nil, err
+	}
+	ranked := make([]RankedBook, len(hits))
+	for i, h := range hits {
+		ranked[i] = RankedBook{Book: h.Book, Matches: h.Matches}
+	}
+	df := float64(len(hits))
+	n := float64(searched)
+
+	switch method {
+	case RankMatches:
+		for i, h := range hits {
+			ranked[i].Score = float64(h.Matches)
+		}
+
+	case RankTFIDF:
+		idf := math.Log(1 + n/df)
+		for i, h := range hits {
+			ranked[i].Score = float64(h.Matches) / float64(max(h.Lines, 1)) * idf
+		}
+
+	case RankBM25:
+		idf := math.Log((n-df+0.5)/(df+0.5) + 1)
+		avg := averageLines(hits, idx)
+		for i, h := range hits {
+			tf := float64(h.Matches)
+			norm := 1 - bm25B + bm25B*float64(h.Lines)/math.Max(avg, 1)
+			ranked[i].Score = idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+		}
+
+	case RankPageRank, RankCloseness:
+		var centrality map[string]float64
+		if method == RankPageRank {
+			centrality = idx.PageRank()
+		} else {
+			centrality = idx.Closeness()
+		}
+		for i, h := range hits {
+			ranked[i].Score = centrality[h.Book]
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Matches > ranked[j].Matches
+	})
+	return ranked, nil
+}
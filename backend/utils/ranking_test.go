@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func rankedBooks(ranked []RankedBook) []string {
+	ids := []string{}
+	for _, r := range ranked {
+		ids = append(ids, r.Book)
+	}
+	return ids
+}
+
+func TestRankBooks(t *testing.T) {
+	hits := []BookHits{
+		{Book: "long", Matches: 10, Lines: 10000},
+		{Book: "short", Matches: 5, Lines: 100},
+		{Book: "tie", Matches: 5, Lines: 100},
+		{Book: "rare", Matches: 1, Lines: 50},
+	}
+	tests := []struct {
+		method string
+		want   []string
+	}{
+		{RankMatches, []string{"long", "short", "tie", "rare"}},
+		{RankTFIDF, []string{"short", "tie", "rare", "long"}},
+		{RankBM25, []string{"short", "tie", "rare", "long"}},
+	}
+	for _, test := range tests {
+		ranked, err := RankBooks(test.method, hits, 10, nil)
+		if err != nil {
+			t.Fatalf("RankBooks(%s): %v", test.method, err)
+		}
+		if got := rankedBooks(ranked); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("RankBooks(%s) = %q, want %q", test.method, got, test.want)
+		}
+	}
+
+	// BM25 saturates : twice the matches in a book of the same length is
+	// not twice the score, and a shorter book scores higher
+	ranked, _ := RankBooks(RankBM25, []BookHits{
+		{Book: "a", Matches: 10, Lines: 100},
+		{Book: "b", Matches: 20, Lines: 100},
+		{Book: "c", Matches: 10, Lines: 50},
+	}, 10, nil)
+	scores := map[string]float64{}
+	for _, r := range ranked {
+		scores[r.Book] = r.Score
+	}
+	if scores["b"] <= scores["a"] || scores["b"] >= 2*scores["a"] || scores["c"] <= scores["a"] {
+		t.Errorf("BM25 scores = %v", scores)
+	}
+}
+
+func TestCheckRankMethod(t *testing.T) {
+	idx, err := BuildIndex(testBooks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, method := range RankMethods {
+		if err := CheckRankMethod(method, idx); err != nil {
+			t.Errorf("CheckRankMethod(%s) with an index: %v", method, err)
+		}
+	}
+	for _, method := range []string{RankPageRank, RankCloseness} {
+		if err := CheckRankMethod(method, nil); err == nil {
+			t.Errorf("CheckRankMethod(%s) accepted a missing index", method)
+		}
+	}
+	if err := CheckRankMethod("popularity", idx); err == nil {
+		t.Error("CheckRankMethod accepted an unknown method")
+	}
+	if _, err := RankBooks("popularity", nil, 0, idx); err == nil {
+		t.Error("RankBooks accepted an unknown method")
+	}
+}
+
+func TestRankByCentrality(t *testing.T) {
+	idx, err := BuildIndex(testBooks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	hits := []BookHits{{Book: "1", Matches: 1}, {Book: "2", Matches: 1}, {Book: "3", Matches: 1}}
+	for _, method := range []string{RankPageRank, RankCloseness} {
+		centrality := idx.PageRank()
+		if method == RankCloseness {
+			centrality = idx.Closeness()
+		}
+		ranked, err := RankBooks(method, hits, 3, idx)
+		if err != nil {
+			t.Fatalf("RankBooks(%s): %v", method, err)
+		}
+		for i, r := range ranked {
+			if r.Score != centrality[r.Book] {
+				t.Errorf("RankBooks(%s) scores %s %v, want %v", method, r.Book, r.Score, centrality[r.Book])
+			}
+			if i > 0 && r.Score > ranked[i-1].Score {
+				t.Errorf("RankBooks(%s) is not sorted : %v", method, ranked)
+			}
+		}
+	}
+
+	// computed once per index
+	if reflect.ValueOf(idx.PageRank()).Pointer() != reflect.ValueOf(idx.PageRank()).Pointer() {
+		t.Error("PageRank is computed again")
+	}
+	if reflect.ValueOf(idx.Closeness()).Pointer() != reflect.ValueOf(idx.Closeness()).Pointer() {
+		t.Error("Closeness is computed again")
+	}
+}
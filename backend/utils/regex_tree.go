@@ -1,231 +1,414 @@
 package utils
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // regex tree node structure
 type RegexTreeNode struct {
 	operation string
 	value     rune
-	charSet   []rune
+	class     charClass // runes matched by a charset
+	min, max  int       // bounds of a repeat, max is -1 when unbounded
 	left      *RegexTreeNode
 	right     *RegexTreeNode
 }
 
-func (n *RegexTreeNode) isAtom() bool {
-	return n.left == nil && n.right == nil
+// SyntaxError is an invalid regex, with the position of the offending
+// character.
+type SyntaxError struct {
+	Msg    string
+	Column int // in runes, starting at 1
 }
 
-func atomSize(pattern string) int {
-	if len(pattern) == 0 {
-		return 0
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at column %d", e.Msg, e.Column)
+}
+
+// Limits of counted repetitions, which are expanded into copies of their
+// sub-expression when compiled.
+const (
+	maxRepeat   = 1000  // largest bound of {n,m}
+	maxExpanded = 10000 // largest number of atoms after expansion
+)
+
+// parser is a recursive descent parser of the grammar :
+//
+//	alternation := concat ( '|' concat )*
+//	concat      := repeat*
+//	repeat      := atom ( '*' | '+' | '?' | '{n}' | '{n,}' | '{n,m}' )*
+//	atom        := '(' alternation ')' | '[' class ']' | '\' char | '.' | '^' | '$' | char
+type parser struct {
+	runes []rune
+	pos   int
+}
+
+func (p *parser) more() bool {
+	return p.pos < len(p.runes)
+}
+
+func (p *parser) peek() rune {
+	return p.runes[p.pos]
+}
+
+// errorAt returns a syntax error at the rune of index pos.
+func errorAt(pos int, format string, args ...any) *SyntaxError {
+	return &SyntaxError{Msg: fmt.Sprintf(format, args...), Column: pos + 1}
+}
+
+// ParseRegex parses the pattern into a regex tree. The returned error is a
+// *SyntaxError.
+func (n *RegexTreeNode) ParseRegex(pattern string) (*RegexTreeNode, error) {
+	p := &parser{runes: []rune(pattern)}
+	tree, err := p.alternation()
+	if err != nil {
+		return nil, err
 	}
-	if pattern[0] == '[' {
-		end := matchingBracket(pattern)
-		if end == -1 {
-			end = 0
-		}
-		end++
-		if end < len(pattern) && (pattern[end] == '*' || pattern[end] == '+' || pattern[end] == '?') {
-			end++
-		}
-		return end
+	if p.more() {
+		// alternation only stops early on a ')'
+		return nil, errorAt(p.pos, "unexpected ')'")
 	}
-	if pattern[0] == '(' {
-		end := matchingParen(pattern)
-		if end == -1 {
-			end = 0
-		}
-		end++
-		if end < len(pattern) && (pattern[end] == '*' || pattern[end] == '+' || pattern[end] == '?') {
-			end++
+	if tree.expandedSize() > maxExpanded {
+		return nil, errorAt(0, "repetitions expand to more than %d atoms", maxExpanded)
+	}
+	return tree, nil
+}
+
+func (p *parser) alternation() (*RegexTreeNode, error) {
+	left, err := p.concat()
+	if err != nil {
+		return nil, err
+	}
+	if !p.more() || p.peek() != '|' {
+		return left, nil
+	}
+	p.pos++
+	right, err := p.alternation()
+	if err != nil {
+		return nil, err
+	}
+	return &RegexTreeNode{operation: "or", left: left, right: right}, nil
+}
+
+func (p *parser) concat() (*RegexTreeNode, error) {
+	nodes := []*RegexTreeNode{}
+	for p.more() && p.peek() != '|' && p.peek() != ')' {
+		node, err := p.repeat()
+		if err != nil {
+			return nil, err
 		}
-		return end
+		nodes = append(nodes, node)
+	}
+	if len(nodes) == 0 {
+		// e.g. "a|" or "()", matches the empty string
+		return &RegexTreeNode{operation: "empty"}, nil
 	}
-	size := 1
-	if size < len(pattern) && (pattern[size] == '*' || pattern[size] == '+' || pattern[size] == '?') {
-		size++
+	tree := nodes[len(nodes)-1]
+	for i := len(nodes) - 2; i >= 0; i-- {
+		tree = &RegexTreeNode{operation: "concat", left: nodes[i], right: tree}
 	}
-	return size
+	return tree, nil
 }
 
-func matchingParen(s string) int {
-	depth := 0
-	for i, c := range s {
-		if c == '(' {
-			depth++
-		} else if c == ')' {
-			depth--
-			if depth == 0 {
-				return i
+func (p *parser) repeat() (*RegexTreeNode, error) {
+	if c := p.peek(); c == '*' || c == '+' || c == '?' {
+		return nil, errorAt(p.pos, "missing argument to repetition operator '%c'", c)
+	}
+	node, err := p.atom()
+	if err != nil {
+		return nil, err
+	}
+	for p.more() {
+		start := p.pos
+		switch p.peek() {
+		case '*':
+			node = &RegexTreeNode{operation: "star", left: node}
+		case '+':
+			node = &RegexTreeNode{operation: "plus", left: node}
+		case '?':
+			node = &RegexTreeNode{operation: "optional", left: node}
+		case '{':
+			end := start + 1
+			for end < len(p.runes) && p.runes[end] != '}' {
+				end++
+			}
+			if end == len(p.runes) {
+				return node, nil // literal '{'
 			}
+			min, max, ok := parseRepeat(string(p.runes[start+1 : end]))
+			if !ok {
+				return node, nil // literal '{', e.g. {,3}
+			}
+			node = &RegexTreeNode{operation: "repeat", min: min, max: max, left: node}
+			if err := node.checkRepeat(start); err != nil {
+				return nil, err
+			}
+			p.pos = end
+		default:
+			return node, nil
 		}
+		p.pos++
 	}
-	return -1
+	return node, nil
 }
 
-func matchingBracket(s string) int {
-	if len(s) == 0 || s[0] != '[' {
-		return -1
+// checkRepeat validates the bounds of a repeat node written at pos.
+func (n *RegexTreeNode) checkRepeat(pos int) error {
+	if n.min > maxRepeat || n.max > maxRepeat {
+		return errorAt(pos, "invalid repeat count %s, bounds are at most %d", n.repeatText(), maxRepeat)
+	}
+	if n.max != -1 && n.max < n.min {
+		return errorAt(pos, "invalid repeat count %s, max is less than min", n.repeatText())
 	}
-	for i := 1; i < len(s); i++ {
-		if s[i] == ']' {
-			return i
+	if n.expandedSize() > maxExpanded {
+		return errorAt(pos, "repetition %s expands to more than %d atoms", n.repeatText(), maxExpanded)
+	}
+	return nil
+}
+
+func (p *parser) atom() (*RegexTreeNode, error) {
+	start := p.pos
+	c := p.peek()
+	p.pos++
+	switch c {
+	case '(':
+		node, err := p.alternation()
+		if err != nil {
+			return nil, err
+		}
+		if !p.more() {
+			return nil, errorAt(start, "unclosed '('")
+		}
+		p.pos++ // ')'
+		return node, nil
+	case '[':
+		class, err := p.class(start)
+		if err != nil {
+			return nil, err
 		}
-		if s[i] == '\\' && i+1 < len(s) {
-			i++
+		return &RegexTreeNode{operation: "charset", class: class}, nil
+	case '\\':
+		if !p.more() {
+			return nil, errorAt(start, "trailing backslash")
 		}
+		p.pos++
+		return parseEscape(p.runes[p.pos-1]), nil
+	case '.':
+		return &RegexTreeNode{operation: "any"}, nil
+	case '^':
+		return &RegexTreeNode{operation: "assert", value: lineStart}, nil
+	case '$':
+		return &RegexTreeNode{operation: "assert", value: lineEnd}, nil
 	}
-	return -1
+	return &RegexTreeNode{operation: "atom", value: c}, nil
 }
 
-func parseCharacterClass(content string) []rune {
-	var charSet []rune
-	i := 0
-	for i < len(content) {
-		if i+2 < len(content) && content[i+1] == '-' {
-			start := rune(content[i])
-			end := rune(content[i+2])
-			for r := start; r <= end; r++ {
-				charSet = append(charSet, r)
+// class parses the content of [...], e.g. "^a-zà-ÿ\d]", after the '[' at
+// start. A ']' first in the class is a literal character.
+func (p *parser) class(start int) (charClass, error) {
+	class := charClass{}
+	if p.more() && p.peek() == '^' {
+		class.negated = true
+		p.pos++
+	}
+	ranges := []runeRange{}
+	first := true
+	for {
+		if !p.more() {
+			return charClass{}, errorAt(start, "unclosed '['")
+		}
+		if p.peek() == ']' && !first {
+			p.pos++
+			break
+		}
+		first = false
+
+		lo, escape, err := p.classChar()
+		if err != nil {
+			return charClass{}, err
+		}
+		if escape != nil {
+			// \d \w \s add their class
+			ranges = append(ranges, escape.class.matched()...)
+			continue
+		}
+		hi := lo
+		if p.pos+1 < len(p.runes) && p.peek() == '-' && p.runes[p.pos+1] != ']' {
+			dash := p.pos
+			p.pos++
+			hi, escape, err = p.classChar()
+			if err != nil {
+				return charClass{}, err
+			}
+			if escape != nil || hi < lo {
+				return charClass{}, errorAt(dash-1, "invalid character class range %s", string(p.runes[dash-1:p.pos]))
 			}
-			i += 3
-		} else {
-			charSet = append(charSet, rune(content[i]))
-			i++
 		}
+		ranges = append(ranges, runeRange{lo, hi})
 	}
-	return charSet
+	class.ranges = normalizeRanges(ranges)
+	return class, nil
 }
 
-func splitAtLastAtom(s string) (string, string) {
-	if len(s) == 0 {
-		return "", ""
+// classChar reads a character of a class, escape is set for \d, \w, \s and
+// their negations.
+func (p *parser) classChar() (c rune, escape *RegexTreeNode, err error) {
+	c = p.peek()
+	p.pos++
+	if c != '\\' {
+		return c, nil, nil
 	}
-	i := 0
-	lastStart := 0
-	lastSize := 0
-	for i < len(s) {
-		size := atomSize(s[i:])
-		if size <= 0 {
-			lastStart = i
-			lastSize = len(s) - i
-			break
-		}
-		lastStart = i
-		lastSize = size
-		i += size
+	if !p.more() {
+		return 0, nil, errorAt(p.pos-1, "trailing backslash")
 	}
-	if lastSize == 0 {
-		return s, ""
+	node := parseEscape(p.peek())
+	p.pos++
+	switch node.operation {
+	case "charset":
+		return 0, node, nil
+	case "assert":
+		return 0, nil, errorAt(p.pos-2, "invalid escape %s in character class", assertionText(node.value))
 	}
-	prefix := s[:lastStart]
-	lastAtom := s[lastStart : lastStart+lastSize]
-	return prefix, lastAtom
+	return node.value, nil, nil
 }
 
-func (n *RegexTreeNode) ParseRegex(pattern string) *RegexTreeNode {
-	if len(pattern) == 0 {
-		return nil
+// parseEscape returns the node of the escape sequence \c.
+func parseEscape(c rune) *RegexTreeNode {
+	switch c {
+	case 'b':
+		return &RegexTreeNode{operation: "assert", value: wordBoundary}
+	case 'B':
+		return &RegexTreeNode{operation: "assert", value: nonWordBoundary}
+	case 'd', 'D':
+		return &RegexTreeNode{operation: "charset", class: charClass{ranges: digitClass, negated: c == 'D'}}
+	case 'w', 'W':
+		return &RegexTreeNode{operation: "charset", class: charClass{ranges: wordClass, negated: c == 'W'}}
+	case 's', 'S':
+		return &RegexTreeNode{operation: "charset", class: charClass{ranges: spaceClass, negated: c == 'S'}}
+	case 't':
+		return &RegexTreeNode{operation: "atom", value: '\t'}
+	case 'n':
+		return &RegexTreeNode{operation: "atom", value: '\n'}
+	case 'r':
+		return &RegexTreeNode{operation: "atom", value: '\r'}
+	case 'f':
+		return &RegexTreeNode{operation: "atom", value: '\f'}
+	case 'v':
+		return &RegexTreeNode{operation: "atom", value: '\v'}
 	}
-	if len(pattern) == 1 {
-		if pattern[0] != '(' && pattern[0] != ')' && pattern[0] != '[' && pattern[0] != ']' {
-			return &RegexTreeNode{operation: "atom", value: rune(pattern[0])}
-		}
-		return nil
-	}
-	depth := 0
-	bracketDepth := 0
-	for i, char := range pattern {
-		switch char {
-		case '(':
-			depth++
-		case ')':
-			depth--
-		case '[':
-			bracketDepth++
-		case ']':
-			bracketDepth--
-		case '|':
-			if depth == 0 && bracketDepth == 0 {
-				return &RegexTreeNode{
-					operation: "or",
-					left:      n.ParseRegex(pattern[:i]),
-					right:     n.ParseRegex(pattern[i+1:]),
-				}
-			}
-		}
+	// any other escaped character is taken literally : \. \* \( \[ \\ ...
+	return &RegexTreeNode{operation: "atom", value: c}
+}
+
+// parseRepeat reads the content of a counted repetition : "n", "n," or "n,m".
+func parseRepeat(content string) (min, max int, ok bool) {
+	lo, hi, comma := strings.Cut(content, ",")
+	min, ok = repeatBound(lo)
+	if !ok {
+		return 0, 0, false
 	}
-	if pattern[0] == '(' && matchingParen(pattern) == len(pattern)-1 {
-		return n.ParseRegex(pattern[1 : len(pattern)-1])
-	}
-	last := pattern[len(pattern)-1]
-	if last == '*' || last == '+' || last == '?' {
-		op := map[byte]string{'*': "star", '+': "plus", '?': "optional"}[last]
-		inner := pattern[:len(pattern)-1]
-		prefix, atom := splitAtLastAtom(inner)
-		if atom == "" {
-			return &RegexTreeNode{
-				operation: op,
-				left:      n.ParseRegex(inner),
-			}
-		}
-		quantNode := &RegexTreeNode{
-			operation: op,
-			left:      n.ParseRegex(atom),
-		}
-		if prefix == "" {
-			return quantNode
-		}
-		return &RegexTreeNode{
-			operation: "concat",
-			left:      n.ParseRegex(prefix),
-			right:     quantNode,
+	if !comma {
+		return min, min, true
+	}
+	if hi == "" {
+		return min, -1, true
+	}
+	max, ok = repeatBound(hi)
+	return min, max, ok
+}
+
+// repeatBound reads a bound of a counted repetition, too large numbers are
+// kept above maxRepeat to be reported by checkRepeat.
+func repeatBound(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, false
 		}
 	}
-	split := atomSize(pattern)
-	if split >= len(pattern) {
-		if pattern[0] == '[' {
-			end := matchingBracket(pattern)
-			if end != -1 && end == len(pattern)-1 {
-				charSet := parseCharacterClass(pattern[1:end])
-				return &RegexTreeNode{operation: "charset", charSet: charSet}
-			}
+	bound, err := strconv.Atoi(s)
+	if err != nil {
+		return maxRepeat + 1, true
+	}
+	return bound, true
+}
+
+// expandedSize is the number of atoms of the tree once repetitions are
+// expanded, see buildState.
+func (n *RegexTreeNode) expandedSize() int {
+	if n == nil {
+		return 0
+	}
+	switch n.operation {
+	case "concat", "or":
+		return n.left.expandedSize() + n.right.expandedSize()
+	case "star", "optional":
+		return n.left.expandedSize()
+	case "plus":
+		return 2 * n.left.expandedSize()
+	case "repeat":
+		copies := n.max
+		if copies == -1 {
+			copies = n.min + 1
 		}
-		return &RegexTreeNode{operation: "atom", value: rune(pattern[0])}
+		return copies * n.left.expandedSize()
 	}
-	return &RegexTreeNode{
-		operation: "concat",
-		left:      n.ParseRegex(pattern[:split]),
-		right:     n.ParseRegex(pattern[split:]),
+	return 1
+}
+
+// repeatText is the quantifier of a repeat node, e.g. {2,5}.
+func (n *RegexTreeNode) repeatText() string {
+	switch {
+	case n.max == -1:
+		return fmt.Sprintf("{%d,}", n.min)
+	case n.max == n.min:
+		return fmt.Sprintf("{%d}", n.min)
 	}
+	return fmt.Sprintf("{%d,%d}", n.min, n.max)
+}
+
+func (n *RegexTreeNode) isAtom() bool {
+	return n.left == nil && n.right == nil
 }
 
 func (n *RegexTreeNode) PrintTree() {
 	if n == nil {
-		print("nil")
+		fmt.Print("nil")
 		return
 	}
 	if n.isAtom() {
 		if n.operation == "charset" {
-			print("[")
-			for _, r := range n.charSet {
-				print(string(r))
-			}
-			print("]")
+			fmt.Print(n.class.String())
+		} else if n.operation == "assert" {
+			fmt.Print(assertionText(n.value))
+		} else if n.operation == "any" {
+			fmt.Print(".")
+		} else if n.operation == "empty" {
+			fmt.Print("ε")
 		} else {
-			print(string(n.value))
+			fmt.Print(string(n.value))
 		}
 		return
 	}
-	print(n.operation + " ( ")
+	if n.operation == "repeat" {
+		fmt.Print("repeat" + n.repeatText() + " ( ")
+	} else {
+		fmt.Print(n.operation + " ( ")
+	}
 	if n.left != nil {
 		n.left.PrintTree()
 	} else {
-		print("nil")
+		fmt.Print("nil")
 	}
 	if n.right != nil {
-		print(" , ")
+		fmt.Print(" , ")
 		n.right.PrintTree()
-		print(" ) ")
+		fmt.Print(" ) ")
 	} else {
-		print(" ) ")
+		fmt.Print(" ) ")
 	}
 }
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRegexErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		msg     string
+		column  int
+	}{
+		{"ab(cd", "unclosed '('", 3},
+		{"a(b(c)", "unclosed '('", 2},
+		{"ab)", "unexpected ')'", 3},
+		{"*a", "missing argument to repetition operator '*'", 1},
+		{"a|+b", "missing argument to repetition operator '+'", 3},
+		{"(?a)", "missing argument to repetition operator '?'", 2},
+		{"ab\\", "trailing backslash", 3},
+		{"[ab", "unclosed '['", 1},
+		{"x[]a", "unclosed '['", 2},
+		{"[z-a]", "invalid character class range z-a", 2},
+		{"[a-\\d]", "invalid character class range a-\\d", 2},
+		{"[\\b]", "invalid escape \\b in character class", 2},
+		{"[a\\", "trailing backslash", 3},
+		{"a{3,2}", "invalid repeat count {3,2}, max is less than min", 2},
+		{"a{1001}", "invalid repeat count {1001}, bounds are at most 1000", 2},
+		{"(abcdefghijk){1000}", "repetition {1000} expands to more than 10000 atoms", 14},
+		{"(a{100}){101}", "repetition {101} expands to more than 10000 atoms", 9},
+		// columns count runes, not bytes
+		{"été(", "unclosed '('", 4},
+		{"[é-a]", "invalid character class range é-a", 2},
+	}
+	for _, test := range tests {
+		_, err := (&RegexTreeNode{}).ParseRegex(test.pattern)
+		var syntax_err *SyntaxError
+		if !errors.As(err, &syntax_err) {
+			t.Errorf("ParseRegex(%q) : got error %v, want a *SyntaxError", test.pattern, err)
+			continue
+		}
+		if syntax_err.Msg != test.msg || syntax_err.Column != test.column {
+			t.Errorf("ParseRegex(%q) : got %q at column %d, want %q at column %d", test.pattern, syntax_err.Msg, syntax_err.Column, test.msg, test.column)
+		}
+	}
+}
+
+func TestParseRegexValid(t *testing.T) {
+	// special characters which are literal where they stand
+	patterns := []string{"a{", "a{,3}", "a{b}", "{", "[]a]", "[a-]", "[-a]", "a]", "()", "a|", "|", "[^]]", "x{2}{3}"}
+	for _, pattern := range patterns {
+		if _, err := (&RegexTreeNode{}).ParseRegex(pattern); err != nil {
+			t.Errorf("ParseRegex(%q) : unexpected error %v", pattern, err)
+		}
+	}
+}
+
+func TestCompileSyntaxError(t *testing.T) {
+	_, err := Compile("Sarg(on", Options{})
+	var syntax_err *SyntaxError
+	if !errors.As(err, &syntax_err) {
+		t.Fatalf("Compile : got error %v, want a *SyntaxError", err)
+	}
+	if got := err.Error(); got != "unclosed '(' at column 5" {
+		t.Errorf("Compile : got %q", got)
+	}
+}